@@ -2,10 +2,12 @@ package config
 
 import (
 	"fmt"
+	"gitbeam.commit.monitor/models"
 	"github.com/joho/godotenv"
 	"go/build"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const ServiceName = "gitbeam.commit.monitor"
@@ -14,6 +16,8 @@ type Secrets struct {
 	CommitDatabaseName string `json:"COMMIT_DATABASE_NAME"`
 	CronDatabaseName   string `json:"CRON_DATABASE_NAME"`
 	Port               string
+	WebhookPort        string              // Serves push webhooks over HTTP when set.
+	SourceHosts        []models.SourceHost // github.com, gitlab.com and those listed in GITHUB_HOSTS, GITLAB_HOSTS, GITEA_HOSTS and LOCAL_HOSTS.
 }
 
 var ss Secrets
@@ -32,6 +36,58 @@ func init() {
 	if ss.Port = os.Getenv("PORT"); ss.Port == "" {
 		ss.Port = "80"
 	}
+	ss.WebhookPort = os.Getenv("WEBHOOK_PORT")
+
+	ss.SourceHosts = []models.SourceHost{
+		{Provider: models.ProviderGithub, Name: models.GithubDotComHost, Tokens: readTokens("GITHUB_TOKENS")},
+		{Provider: models.ProviderGitlab, Name: models.GitlabDotComHost, Tokens: readTokens("GITLAB_TOKENS")},
+	}
+
+	ss.SourceHosts = append(ss.SourceHosts, readHosts(models.ProviderGithub, "GITHUB")...)
+	ss.SourceHosts = append(ss.SourceHosts, readHosts(models.ProviderGitlab, "GITLAB")...)
+	ss.SourceHosts = append(ss.SourceHosts, readHosts(models.ProviderGitea, "GITEA")...)
+	ss.SourceHosts = append(ss.SourceHosts, readHosts(models.ProviderLocal, "LOCAL")...)
+}
+
+// readHosts reads the self-hosted instances of a provider listed in <prefix>_HOSTS. Each one is configured
+// under <prefix>_HOST_<NAME>_*, e.g. GITLAB_HOST_ACME_BASE_URL for the GitLab host "acme".
+func readHosts(provider, prefix string) []models.SourceHost {
+	hosts := make([]models.SourceHost, 0)
+	for _, name := range splitTokens(os.Getenv(prefix+"_HOSTS"), ",") {
+		hostPrefix := prefix + "_HOST_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		hosts = append(hosts, models.SourceHost{
+			Provider:  provider,
+			Name:      name,
+			BaseURL:   os.Getenv(hostPrefix + "_BASE_URL"),
+			UploadURL: os.Getenv(hostPrefix + "_UPLOAD_URL"),
+			Tokens:    readTokens(hostPrefix + "_TOKENS"),
+		})
+	}
+	return hosts
+}
+
+// readTokens reads the comma separated tokens in the named variable, along with those in the file named by <name>_FILE.
+func readTokens(name string) []string {
+	tokens := splitTokens(os.Getenv(name), ",")
+	if tokensFile := os.Getenv(name + "_FILE"); tokensFile != "" {
+		if data, err := os.ReadFile(tokensFile); err == nil {
+			tokens = append(tokens, splitTokens(string(data), "\n")...)
+		}
+	}
+	return tokens
+}
+
+// splitTokens splits a list of personal access tokens, skipping blanks and # comments.
+func splitTokens(input, separator string) []string {
+	tokens := make([]string, 0)
+	for _, token := range strings.Split(input, separator) {
+		token = strings.TrimSpace(token)
+		if token == "" || strings.HasPrefix(token, "#") {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
 }
 
 // GetSecrets is used to get value from the Secrets runtime.
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"strings"
+)
+
+// ImportMailmap saves every alias in a .mailmap file, replacing the canonical identity of aliases already stored.
+func (g GitBeamService) ImportMailmap(ctx context.Context, content string) (int, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ImportMailmap")
+
+	aliases, err := models.ParseMailmap(strings.NewReader(content))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = g.dataStore.SaveAuthorAliases(ctx, aliases); err != nil {
+		useLogger.WithError(err).Errorln("failed to save author aliases")
+		return 0, err
+	}
+
+	return len(aliases), nil
+}
+
+func (g GitBeamService) SaveAuthorAlias(ctx context.Context, alias models.AuthorAlias) error {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "SaveAuthorAlias")
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+
+	if err := g.dataStore.SaveAuthorAliases(ctx, []*models.AuthorAlias{&alias}); err != nil {
+		useLogger.WithError(err).Errorln("failed to save author alias")
+		return err
+	}
+
+	return nil
+}
+
+func (g GitBeamService) ListAuthorAliases(ctx context.Context) ([]*models.AuthorAlias, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListAuthorAliases")
+
+	list, err := g.dataStore.ListAuthorAliases(ctx)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list author aliases from database")
+		return make([]*models.AuthorAlias, 0), nil
+	}
+
+	return list, nil
+}
+
+func (g GitBeamService) DeleteAuthorAlias(ctx context.Context, alias models.AuthorAlias) error {
+	return g.dataStore.DeleteAuthorAlias(ctx, alias.AliasName, alias.AliasEmail)
+}
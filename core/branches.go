@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"path"
+)
+
+// ResolveBranches expands the branch names and globs (e.g. release/*) of a monitor into the branches
+// that currently exist on the repository. No patterns means just the default branch.
+func (g GitBeamService) ResolveBranches(ctx context.Context, owner models.OwnerAndRepoName, patterns []string) ([]string, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ResolveBranches")
+
+	if len(patterns) == 0 {
+		branch, err := g.getDefaultBranch(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		return []string{branch}, nil
+	}
+
+	matched := make([]string, 0)
+	page := 1
+
+	for {
+		var branches []string
+		response, err := g.callSource(ctx, owner.Host, func(src source.CommitSource) (response *source.Response, err error) {
+			branches, response, err = src.ListBranches(ctx, owner, page)
+			return response, err
+		})
+		if err != nil {
+			useLogger.WithError(err).Error("failed to list branches from source")
+			return nil, err
+		}
+
+		for _, branch := range branches {
+			for _, pattern := range patterns {
+				if ok, _ := path.Match(pattern, branch); ok {
+					matched = append(matched, branch)
+					break
+				}
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		page = response.NextPage
+	}
+
+	return matched, nil
+}
+
+func (g GitBeamService) getDefaultBranch(ctx context.Context, owner models.OwnerAndRepoName) (string, error) {
+	var repo *models.Repository
+	_, err := g.callSource(ctx, owner.Host, func(src source.CommitSource) (response *source.Response, err error) {
+		repo, response, err = src.GetRepository(ctx, owner)
+		return response, err
+	})
+	if err != nil {
+		g.logger.WithContext(ctx).WithError(err).Error("failed to get repository from source")
+		return "", err
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+// branchOrDefault returns the branch the filters target, falling back to the repository's default branch.
+func (g GitBeamService) branchOrDefault(ctx context.Context, filters models.CommitFilters) (string, error) {
+	if filters.Branch != "" {
+		return filters.Branch, nil
+	}
+	return g.getDefaultBranch(ctx, filters.OwnerAndRepoName)
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"gitbeam.commit.monitor/models"
+)
+
+var ErrChangelogRangeEnd = errors.New("toSha is required when fromSha is set")
+
+// GetChangelog groups the conventional commits of a SHA range or, when no SHAs are given, of a date window.
+func (g GitBeamService) GetChangelog(ctx context.Context, params models.ChangelogParams) ([]*models.ChangelogSection, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetChangelog")
+
+	if params.FromSHA != "" || params.ToSHA != "" {
+		if params.ToSHA == "" {
+			return nil, ErrChangelogRangeEnd
+		}
+
+		commits, err := g.dataStore.ListCommitsBetween(ctx, params.OwnerAndRepoName, params.FromSHA, params.ToSHA)
+		if err != nil {
+			useLogger.WithError(err).Errorln("failed to list commits between SHAs from database")
+			return nil, err
+		}
+
+		return models.NewChangelog(commits), nil
+	}
+
+	filters := models.CommitFilters{
+		OwnerAndRepoName: params.OwnerAndRepoName,
+		Branch:           params.Branch,
+		FromDate:         params.FromDate,
+		ToDate:           params.ToDate,
+		Limit:            100,
+	}
+
+	commits := make([]*models.Commit, 0)
+	for {
+		page, err := g.dataStore.ListCommits(ctx, filters)
+		if err != nil {
+			useLogger.WithError(err).Errorln("failed to list commits from database")
+			return nil, err
+		}
+
+		commits = append(commits, page...)
+		if int64(len(page)) < filters.Limit {
+			break
+		}
+		filters.Page += int64(len(page)) // Page is the row offset of ListCommits.
+	}
+
+	return models.NewChangelog(commits), nil
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gitbeam.commit.monitor/events/topics"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"sync"
+	"time"
+)
+
+// The circuit of a source host opens after circuitFailureThreshold calls in a row failed because of the host,
+// and stays open for circuitOpenDuration before a probe call is let through.
+const (
+	circuitFailureThreshold = 10
+	circuitOpenDuration     = time.Minute
+)
+
+// circuitBreaker stops calls to a source host while it is down, so that during an outage every job fails fast
+// instead of retrying against it on its own. Only failures of the host itself count, e.g. server errors and
+// timeouts; a missing repository or an exhausted rate limit says nothing about its health.
+type circuitBreaker struct {
+	health   models.SourceHostHealth
+	onChange func(health models.SourceHostHealth) // Called outside of mu.
+	probing  bool                                 // A half-open circuit lets a single probe through at a time.
+	now      func() time.Time
+	mu       sync.Mutex
+}
+
+func newCircuitBreaker(host models.SourceHost, onChange func(health models.SourceHostHealth)) *circuitBreaker {
+	return &circuitBreaker{
+		onChange: onChange,
+		now:      time.Now,
+		health: models.SourceHostHealth{
+			ChangedAt: time.Now(),
+			Host:      host.Name,
+			Provider:  host.Provider,
+			State:     models.CircuitClosed,
+		},
+	}
+}
+
+// allow reports whether a call may go through. Once the circuit has been open for circuitOpenDuration,
+// the next call is let through as the probe.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+
+	allowed, changed := true, false
+	switch b.health.State {
+	case models.CircuitOpen:
+		if b.now().Sub(b.health.ChangedAt) < circuitOpenDuration {
+			allowed = false
+			break
+		}
+		b.moveTo(models.CircuitHalfOpen)
+		b.probing, changed = true, true
+	case models.CircuitHalfOpen:
+		allowed = !b.probing
+		b.probing = true
+	}
+
+	health := b.health
+	b.mu.Unlock()
+
+	if changed {
+		b.onChange(health)
+	}
+	return allowed
+}
+
+// record feeds the outcome of a call that was allowed through back into the breaker.
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+
+	wasProbe := b.health.State == models.CircuitHalfOpen
+	if wasProbe {
+		b.probing = false
+	}
+
+	changed := false
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		// The caller gave up, which says nothing about the host. A half-open circuit waits for the next probe.
+	case errors.Is(err, source.ErrUnavailable):
+		b.health.ConsecutiveFailures++
+		b.health.LastError = err.Error()
+		if wasProbe || b.health.State == models.CircuitClosed && b.health.ConsecutiveFailures >= circuitFailureThreshold {
+			b.moveTo(models.CircuitOpen)
+			changed = true
+		}
+	default:
+		b.health.ConsecutiveFailures = 0
+		if b.health.State != models.CircuitClosed {
+			b.health.LastError = ""
+			b.moveTo(models.CircuitClosed)
+			changed = true
+		}
+	}
+
+	health := b.health
+	b.mu.Unlock()
+
+	if changed {
+		b.onChange(health)
+	}
+}
+
+// moveTo changes the state of the circuit. Must be called with mu held.
+func (b *circuitBreaker) moveTo(state models.CircuitState) {
+	b.health.State = state
+	b.health.ChangedAt = b.now()
+}
+
+func (b *circuitBreaker) snapshot() models.SourceHostHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.health
+}
+
+// GetSourceHostsHealth returns the circuit breaker state of every configured source host, ordered by host name.
+func (g GitBeamService) GetSourceHostsHealth() []models.SourceHostHealth {
+	list := make([]models.SourceHostHealth, 0, len(g.sourceHosts))
+	for _, configured := range g.sourceHosts {
+		list = append(list, configured.breaker.snapshot())
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Host < list[j].Host
+	})
+	return list
+}
+
+func (g GitBeamService) publishSourceHostHealth(health models.SourceHostHealth) {
+	useLogger := g.logger.WithField("methodName", "publishSourceHostHealth").WithFields(logrus.Fields{
+		"host":                health.Host,
+		"state":               health.State,
+		"consecutiveFailures": health.ConsecutiveFailures,
+	})
+
+	if health.State == models.CircuitOpen {
+		useLogger.WithField("lastError", health.LastError).Warn("source host keeps failing, pausing calls to it")
+	} else {
+		useLogger.Info("source host circuit changed")
+	}
+
+	data, _ := json.Marshal(health)
+	_ = g.eventStore.Publish(topics.SourceHostHealth, data)
+}
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+// newTestCircuitBreaker returns a breaker on clock, which records the states it publishes in changes.
+func newTestCircuitBreaker(clock *testClock, changes *[]models.CircuitState) *circuitBreaker {
+	breaker := newCircuitBreaker(models.SourceHost{Name: "ghe", Provider: models.ProviderGithub}, func(health models.SourceHostHealth) {
+		*changes = append(*changes, health.State)
+	})
+	breaker.now = clock.Now
+	return breaker
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	unavailable := fmt.Errorf("%w: 502 bad gateway", source.ErrUnavailable)
+
+	type step struct {
+		advance   time.Duration // Moves the clock before the call.
+		err       error         // Outcome of the call, recorded when it is allowed.
+		wantAllow bool
+		wantState models.CircuitState
+	}
+
+	// failing is n calls failing because of the host, from a closed circuit. The last one opens it at the threshold.
+	failing := func(n int) []step {
+		steps := make([]step, 0, n)
+		for i := 1; i <= n; i++ {
+			state := models.CircuitClosed
+			if i >= circuitFailureThreshold {
+				state = models.CircuitOpen
+			}
+			steps = append(steps, step{err: unavailable, wantAllow: true, wantState: state})
+		}
+		return steps
+	}
+
+	tests := []struct {
+		name        string
+		steps       []step
+		wantChanges string
+	}{
+		{
+			name:        "opens at the threshold",
+			steps:       append(failing(circuitFailureThreshold), step{wantAllow: false, wantState: models.CircuitOpen}),
+			wantChanges: "[open]",
+		},
+		{
+			name: "success resets the failures",
+			steps: append(append(failing(circuitFailureThreshold-1),
+				step{wantAllow: true, wantState: models.CircuitClosed}),
+				failing(circuitFailureThreshold-1)...),
+			wantChanges: "[]",
+		},
+		{
+			name: "failures of the caller don't count",
+			steps: []step{
+				{err: source.ErrNotFound, wantAllow: true, wantState: models.CircuitClosed},
+				{err: source.ErrAccessDenied, wantAllow: true, wantState: models.CircuitClosed},
+				{err: context.Canceled, wantAllow: true, wantState: models.CircuitClosed},
+				{err: &source.RateLimitError{}, wantAllow: true, wantState: models.CircuitClosed},
+			},
+			wantChanges: "[]",
+		},
+		{
+			name: "stays open until probed",
+			steps: append(failing(circuitFailureThreshold),
+				step{advance: circuitOpenDuration - time.Second, wantAllow: false, wantState: models.CircuitOpen},
+				step{advance: time.Second, wantAllow: true, wantState: models.CircuitClosed},
+			),
+			wantChanges: "[open half_open closed]",
+		},
+		{
+			name: "failed probe opens again",
+			steps: append(failing(circuitFailureThreshold),
+				step{advance: circuitOpenDuration, err: unavailable, wantAllow: true, wantState: models.CircuitOpen},
+				step{advance: circuitOpenDuration - time.Second, wantAllow: false, wantState: models.CircuitOpen},
+				step{advance: time.Second, wantAllow: true, wantState: models.CircuitClosed},
+			),
+			wantChanges: "[open half_open open half_open closed]",
+		},
+		{
+			name: "cancelled probe waits for the next one",
+			steps: append(failing(circuitFailureThreshold),
+				step{advance: circuitOpenDuration, err: context.Canceled, wantAllow: true, wantState: models.CircuitHalfOpen},
+				step{wantAllow: true, wantState: models.CircuitClosed},
+			),
+			wantChanges: "[open half_open closed]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			changes := make([]models.CircuitState, 0)
+			breaker := newTestCircuitBreaker(clock, &changes)
+
+			for i, step := range tt.steps {
+				clock.advance(step.advance)
+				allowed := breaker.allow()
+				if allowed != step.wantAllow {
+					t.Fatalf("step %d: allow() = %v, want %v", i, allowed, step.wantAllow)
+				}
+				if allowed {
+					breaker.record(step.err)
+				}
+				if state := breaker.snapshot().State; state != step.wantState {
+					t.Fatalf("step %d: state = %s, want %s", i, state, step.wantState)
+				}
+			}
+
+			if got := fmt.Sprint(changes); got != tt.wantChanges {
+				t.Errorf("published %v, want %v", got, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	clock := newTestClock()
+	changes := make([]models.CircuitState, 0)
+	breaker := newTestCircuitBreaker(clock, &changes)
+
+	for i := 0; i < circuitFailureThreshold; i++ {
+		breaker.allow()
+		breaker.record(source.ErrUnavailable)
+	}
+
+	clock.advance(circuitOpenDuration)
+	if !breaker.allow() {
+		t.Fatal("allow() = false, want the probe let through")
+	}
+
+	// Calls made while the probe is out are held back, however long it takes.
+	clock.advance(circuitOpenDuration)
+	if breaker.allow() {
+		t.Fatal("allow() = true during the probe, want a single probe")
+	}
+
+	breaker.record(nil)
+	if !breaker.allow() {
+		t.Error("allow() = false after the probe succeeded")
+	}
+}
+
+func TestCircuitBreakerPerHost(t *testing.T) {
+	service := newTestService(t, nil,
+		models.SourceHost{Provider: models.ProviderGithub, Name: "down"},
+		models.SourceHost{Provider: models.ProviderGithub, Name: "up"},
+	)
+
+	for i := 0; i < circuitFailureThreshold; i++ {
+		service.sourceHosts["down"].breaker.allow()
+		service.sourceHosts["down"].breaker.record(source.ErrUnavailable)
+	}
+
+	_, err := service.callSource(context.Background(), "down", func(source.CommitSource) (*source.Response, error) {
+		t.Error("callSource(down) called the host while its circuit is open")
+		return nil, nil
+	})
+	if !errors.Is(err, ErrSourceCircuitOpen) {
+		t.Errorf("callSource(down) error = %v, want %v", err, ErrSourceCircuitOpen)
+	}
+
+	called := false
+	_, err = service.callSource(context.Background(), "up", func(source.CommitSource) (*source.Response, error) {
+		called = true
+		return nil, nil
+	})
+	if err != nil || !called {
+		t.Errorf("callSource(up) = %v, called %v, want the call made", err, called)
+	}
+
+	for _, health := range service.GetSourceHostsHealth() {
+		if want := map[string]models.CircuitState{"down": models.CircuitOpen, "up": models.CircuitClosed}[health.Host]; health.State != want {
+			t.Errorf("%s state = %s, want %s", health.Host, health.State, want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"time"
+)
+
+// maxPendingChecksRefreshed caps how many commits with running checks are refreshed per sync, newest first,
+// so checks that never finish don't pile up calls.
+const maxPendingChecksRefreshed = 100
+
+// checksGracePeriod is how long after it was committed a commit without checks is still looked at again, as checks
+// are often fetched as soon as a commit is pushed, before CI registered any.
+const checksGracePeriod = 24 * time.Hour
+
+// ensureCommitChecks fetches the checks of a commit, unless they were fetched before.
+func (g GitBeamService) ensureCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) error {
+	if checks, _ := g.dataStore.GetCommitChecks(ctx, owner, sha); checks != nil {
+		return nil
+	}
+	return g.refreshCommitChecks(ctx, owner, sha)
+}
+
+func (g GitBeamService) refreshCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) error {
+	var checks *models.CommitChecks
+	_, err := g.callFetcher(ctx, owner.Host, models.FetcherREST, source.CommitChecksCalls, func(src source.CommitSource) (response *source.Response, err error) {
+		checks, response, err = src.GetCommitChecks(ctx, owner, sha)
+		return response, err
+	})
+	if err != nil {
+		return err
+	}
+
+	checks.UpdatedAt = time.Now()
+	return g.dataStore.SaveCommitChecks(ctx, checks)
+}
+
+// RefreshPendingChecks fetches again the checks of the commits of a repository that were still running at the last
+// sync, or had none yet while within checksGracePeriod.
+func (g GitBeamService) RefreshPendingChecks(ctx context.Context, name models.OwnerAndRepoName) error {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "RefreshPendingChecks")
+
+	shas, err := g.dataStore.ListPendingCommitChecks(ctx, name, time.Now().Add(-checksGracePeriod), maxPendingChecksRefreshed)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list pending commit checks from database")
+		return err
+	}
+
+	for _, sha := range shas {
+		if err = g.refreshCommitChecks(ctx, name, sha); err != nil {
+			useLogger.WithError(err).WithField("sha", sha).Error("failed to refresh commit checks from source")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCommitChecks returns what CI reported on a commit of the given repository, as of the last sync.
+func (g GitBeamService) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetCommitChecks")
+	if _, err := g.getStoredCommit(ctx, owner, sha); err != nil {
+		return nil, err
+	}
+
+	// Commits mirrored before checks were tracked have none stored yet.
+	if err := g.ensureCommitChecks(ctx, owner, sha); err != nil {
+		useLogger.WithError(err).Errorln("failed to fetch commit checks from source")
+		return nil, err
+	}
+
+	checks, err := g.dataStore.GetCommitChecks(ctx, owner, sha)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to get commit checks from database")
+		return nil, err
+	}
+
+	return checks, nil
+}
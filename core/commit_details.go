@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+// ensureCommitDetails fetches the line stats and changed files of a commit through the single commit API,
+// unless they are already stored. Files are paged by the source for large commits.
+func (g GitBeamService) ensureCommitDetails(ctx context.Context, owner models.OwnerAndRepoName, sha string) error {
+	if fetched, _ := g.dataStore.HasCommitDetails(ctx, sha); fetched {
+		return nil
+	}
+
+	var commit *models.Commit
+	files := make([]*models.CommitFile, 0)
+	page := 1
+	for {
+		var details *source.CommitDetails
+		response, err := g.callSource(ctx, owner.Host, func(src source.CommitSource) (response *source.Response, err error) {
+			details, response, err = src.GetCommit(ctx, owner, sha, page)
+			return response, err
+		})
+		if err != nil {
+			return err
+		}
+
+		if commit == nil {
+			commit = details.Commit
+		}
+		files = append(files, details.Files...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		page = response.NextPage
+	}
+
+	return g.dataStore.SaveCommitDetails(ctx, sha, commit.Additions, commit.Deletions, files)
+}
+
+// GetCommitFiles returns the files changed by a commit of the given repository.
+func (g GitBeamService) GetCommitFiles(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.CommitFile, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetCommitFiles")
+	if _, err := g.getStoredCommit(ctx, owner, sha); err != nil {
+		return nil, err
+	}
+
+	// Commits synced through GraphQL are stored without their files.
+	if err := g.ensureCommitDetails(ctx, owner, sha); err != nil {
+		useLogger.WithError(err).Errorln("failed to fetch commit files from source")
+	}
+
+	files, err := g.dataStore.ListCommitFiles(ctx, sha)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list commit files from database")
+		return make([]*models.CommitFile, 0), nil
+	}
+
+	return files, nil
+}
@@ -2,45 +2,39 @@ package core
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"gitbeam.baselib/store"
 	"gitbeam.commit.monitor/models"
 	"gitbeam.commit.monitor/repository"
-	"github.com/google/go-github/v63/github"
+	"gitbeam.commit.monitor/source"
 	"github.com/sirupsen/logrus"
-	"log"
 	"net/http"
 	"time"
 )
 
 var (
-	ErrCommitNotFound = errors.New("commit not found")
+	ErrCommitNotFound    = errors.New("commit not found")
+	ErrUnknownSourceHost = errors.New("unknown source host")
+	ErrUnknownFetcher    = errors.New("fetcher is not available on the source host")
+	// ErrSourceCircuitOpen is returned without calling a source host that keeps failing, it wraps source.ErrUnavailable.
+	ErrSourceCircuitOpen = fmt.Errorf("circuit open, calls are paused: %w", source.ErrUnavailable)
 )
 
-func (g GitBeamService) dependOnRateLimitingConstraints(ctx context.Context) (shouldContinue bool, err error) {
-	// Check rate limit
-	rateLimit, _, err := g.githubClient.RateLimit.Get(ctx)
-	if err != nil {
-		return false, err
-	}
-
-	if rateLimit.Core.Remaining == 0 {
-		g.logger.WithFields(logrus.Fields{
-			"rate_limit": rateLimit,
-		}).Info("Current Rate Limit Constraints")
-		sleepDuration := time.Until(rateLimit.Core.Reset.Time)
-		log.Printf("Rate limit exceeded, sleeping for %v", sleepDuration)
-		time.Sleep(sleepDuration)
-	}
-
-	return true, nil
+// sourceHost is a configured host along with the rate budgets shared by every repository on it.
+type sourceHost struct {
+	budget        *rateBudget
+	graphQLBudget *rateBudget // GitHub rate limits its GraphQL API apart from REST, nil on other providers.
+	breaker       *circuitBreaker
+	provider      string
 }
 
 type GitBeamService struct {
-	githubClient *github.Client
-	logger       *logrus.Logger
-	dataStore    repository.DataStore
-	eventStore   store.EventStore
+	sourceHosts map[string]*sourceHost // Keyed by host name, "" is github.com.
+	logger      *logrus.Logger
+	dataStore   repository.DataStore
+	eventStore  store.EventStore
 }
 
 func NewGitBeamService(
@@ -48,13 +42,128 @@ func NewGitBeamService(
 	eventStore store.EventStore,
 	dataStore repository.DataStore,
 	httpClient *http.Client, // Nullable.
-) *GitBeamService {
-	client := github.NewClient(httpClient) // Didn't need to pass this as a top level dependency into the git beam service.
-	return &GitBeamService{
-		githubClient: client,
-		dataStore:    dataStore,
-		eventStore:   eventStore,
-		logger:       logger.WithField("serviceName", "GitBeamService").Logger,
+	hosts []models.SourceHost, // Hosts without tokens fall back to anonymous access.
+) (*GitBeamService, error) {
+	service := &GitBeamService{
+		dataStore:  dataStore,
+		eventStore: eventStore,
+		logger:     logger.WithField("serviceName", "GitBeamService").Logger,
+	}
+
+	sourceHosts := make(map[string]*sourceHost, len(hosts))
+	for _, host := range hosts {
+		if _, exists := sourceHosts[host.Name]; exists {
+			return nil, fmt.Errorf("source host %q is configured twice", host.Name)
+		}
+
+		tokens, err := newSourceTokens(httpClient, host, source.New)
+		if err != nil {
+			return nil, fmt.Errorf("source host %q: %w", host.Name, err)
+		}
+
+		configured := &sourceHost{
+			budget:   newRateBudget(logger, tokens),
+			breaker:  newCircuitBreaker(host, service.publishSourceHostHealth),
+			provider: host.Provider,
+		}
+
+		if host.Provider == models.ProviderGithub {
+			graphQLTokens, err := newSourceTokens(httpClient, host, source.NewGraphQL)
+			if err != nil {
+				return nil, fmt.Errorf("source host %q: %w", host.Name, err)
+			}
+			configured.graphQLBudget = newRateBudget(logger, graphQLTokens)
+		}
+
+		sourceHosts[host.Name] = configured
+	}
+
+	service.sourceHosts = sourceHosts
+	return service, nil
+}
+
+// ResolveSourceHost returns the host and provider a repository is monitored through.
+// A repository that doesn't name its host lives on the public instance of its provider, github.com by default.
+func (g GitBeamService) ResolveSourceHost(provider, host string) (string, string, error) {
+	if host == "" {
+		switch provider {
+		case models.ProviderGithub, "":
+			host = models.GithubDotComHost
+		case models.ProviderGitlab:
+			host = models.GitlabDotComHost
+		default:
+			return "", "", fmt.Errorf("%w: %s repositories must name their host", ErrUnknownSourceHost, provider)
+		}
+	}
+
+	configured, ok := g.sourceHosts[host]
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", ErrUnknownSourceHost, host)
+	}
+
+	if provider != "" && provider != configured.provider {
+		return "", "", fmt.Errorf("%w: %s is a %s host", ErrUnknownSourceHost, host, configured.provider)
+	}
+
+	return host, configured.provider, nil
+}
+
+// callSource runs call with a source from the rate budget of host and reports the outcome back to it.
+func (g GitBeamService) callSource(ctx context.Context, host string, call func(src source.CommitSource) (*source.Response, error)) (*source.Response, error) {
+	return g.callFetcher(ctx, host, models.FetcherREST, 1, call)
+}
+
+// callFetcher is callSource with the source of fetcher, which only listing commits differs by, for source methods
+// making the given number of calls. Fetchers other than REST draw from a rate budget of their own.
+//
+// Transient failures are retried with backoff, see shouldRetry. The error of the last attempt is returned,
+// classified by the source, e.g. as source.ErrNotFound or source.ErrAccessDenied.
+func (g GitBeamService) callFetcher(ctx context.Context, host, fetcher string, calls int, call func(src source.CommitSource) (*source.Response, error)) (*source.Response, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "callFetcher")
+
+	configured, ok := g.sourceHosts[host]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSourceHost, host)
+	}
+
+	budget := configured.budget
+	if fetcher == models.FetcherGraphQL {
+		if budget = configured.graphQLBudget; budget == nil {
+			return nil, fmt.Errorf("%w: %s on %s", ErrUnknownFetcher, fetcher, configured.provider)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if !configured.breaker.allow() {
+			return nil, fmt.Errorf("%w: %s", ErrSourceCircuitOpen, host)
+		}
+
+		token, err := budget.acquire(ctx, calls)
+		if err != nil {
+			configured.breaker.record(err)
+			return nil, err
+		}
+
+		response, err := call(token.source)
+		budget.release(token, response, err)
+		configured.breaker.record(err)
+
+		retry, delay := shouldRetry(ctx, err, attempt)
+		if !retry {
+			return response, err
+		}
+
+		useLogger.WithError(err).WithFields(logrus.Fields{
+			"host":    host,
+			"attempt": attempt,
+			"delay":   delay,
+		}).Warn("retrying failed call to source")
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return response, ctx.Err()
+		}
 	}
 }
 
@@ -89,8 +198,47 @@ func (g GitBeamService) GetTopCommitAuthors(ctx context.Context, filters models.
 	return list, nil
 }
 
+// GetSignatureReport summarizes how many of a repository's commits, within the filters, carry a verified signature.
+func (g GitBeamService) GetSignatureReport(ctx context.Context, filters models.CommitFilters) (*models.SignatureReport, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetSignatureReport")
+
+	report, err := g.dataStore.GetSignatureReport(ctx, filters)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to build signature report from database")
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// GetCommitsBySha returns a commit of the given repository. Commits mirrored outside of enrichmentWindow are
+// stored without their line stats and pull requests, which are fetched the first time the commit is asked for.
 func (g GitBeamService) GetCommitsBySha(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.Commit, error) {
 	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetCommitsBySha")
+	commit, err := g.getStoredCommit(ctx, owner, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	if commit.Additions+commit.Deletions == 0 {
+		err = g.ensureCommitDetails(ctx, owner, sha)
+	}
+
+	if err == nil {
+		err = g.ensureCommitPullRequests(ctx, owner, sha)
+	}
+
+	if err != nil {
+		// The commit is still worth returning without them, e.g. while the source is down.
+		useLogger.WithError(err).Warn("failed to fetch commit details from source")
+		return commit, nil
+	}
+
+	return g.getStoredCommit(ctx, owner, sha)
+}
+
+func (g GitBeamService) getStoredCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.Commit, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "getStoredCommit")
 	commit, err := g.dataStore.GetCommitBySHA(ctx, owner, sha)
 	if err != nil {
 		useLogger.WithError(err).Errorln("failed to fetch commit by owner and sha details from the dataStore.")
@@ -111,81 +259,120 @@ func (g GitBeamService) GetLastCommit(ctx context.Context, owner models.OwnerAnd
 	return commit, nil
 }
 
+// FetchAndSaveCommits backfills the commits in the window described by filters.
+//
+// Progress is checkpointed after every page, so when the process dies or GitHub errors mid-way
+// the next call for the same window picks up from the page it stopped at instead of page 1.
 func (g GitBeamService) FetchAndSaveCommits(ctx context.Context, filters models.CommitFilters) error {
-	useLogger := g.logger.WithContext(ctx).WithField("methodName", "FetchAndSaveCommits")
-	pageNumber := 1
+	_, err := g.backfill(ctx, filters)
+	return err
+}
 
-	//list, _ := g.dataStore.ListCommits(ctx, filters)
-	//if len(list) > 1 {
-	//	// TODO: use the list commit thing as a way to internally skip existing records before pulling new ones from from github.
-	//	//filters.ToDate, _ = models.ParseDate(list[0].Date.Format(time.DateOnly))
-	//	//if len(list) > 1 {
-	//	//	filters.FromDate, _ = models.ParseDate(list[len(list)-1].Date.Format(time.DateOnly))
-	//	//}
-	//	return nil
-	//}
+// backfill is FetchAndSaveCommits, returning how many commits new to the store it wrote.
+func (g GitBeamService) backfill(ctx context.Context, filters models.CommitFilters) (int, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "FetchAndSaveCommits")
 
-	ghOptions := github.CommitsListOptions{
-		ListOptions: github.ListOptions{
-			Page:    pageNumber,
-			PerPage: 1000,
-		},
+	branch, err := g.branchOrDefault(ctx, filters)
+	if err != nil {
+		return 0, err
 	}
 
-	if filters.FromDate != nil {
-		ghOptions.Since = filters.FromDate.Time
+	// A checkpoint we failed to read could be a completed one, starting over would spend the budget on it again.
+	checkpoint, err := g.dataStore.GetBackfillCheckpoint(ctx, filters.OwnerAndRepoName, branch)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		useLogger.WithError(err).Error("failed to fetch backfill checkpoint from the dataStore")
+		return 0, err
 	}
 
-	if filters.ToDate != nil {
-		ghOptions.Until = filters.ToDate.Time
-	}
+	switch {
+	case checkpoint != nil && checkpoint.Covers(filters) && checkpoint.Status == models.BackfillCompleted:
+		useLogger.Info("backfill for this window has already completed")
+		return 0, nil
+	case checkpoint != nil && checkpoint.Covers(filters):
+		useLogger.WithField("page", checkpoint.NextPage).Info("resuming backfill from checkpoint")
+	default:
+		checkpoint = &models.BackfillCheckpoint{
+			Host:      filters.Host,
+			OwnerName: filters.OwnerName,
+			RepoName:  filters.RepoName,
+			Branch:    branch,
+			Status:    models.BackfillRunning,
+			NextPage:  1,
+			Until:     time.Now().UTC().Truncate(time.Second),
+		}
 
-run:
-	ok, err := g.dependOnRateLimitingConstraints(ctx)
-	if err != nil {
-		useLogger.WithError(err).Errorln("failed to fetch commits from github")
-		return nil
-	}
+		if filters.FromDate != nil {
+			checkpoint.Since = filters.FromDate.Time
+		}
 
-	if !ok {
-		return nil
+		if filters.ToDate != nil {
+			checkpoint.Until = filters.ToDate.Time
+		}
 	}
 
-	gitCommits, response, err := g.githubClient.Repositories.ListCommits(ctx, filters.OwnerName, filters.OwnerName, &ghOptions)
-	if err != nil {
-		useLogger.WithError(err).Error("failed to list commits from github")
-		return err
+	options := source.ListCommitsOptions{
+		Branch:  branch,
+		Since:   checkpoint.Since,
+		Until:   checkpoint.Until,
+		Cursor:  checkpoint.NextCursor,
+		Page:    checkpoint.NextPage,
+		PerPage: 100, // GitHub caps pages at 100 commits.
 	}
 
-	for _, gitCommit := range gitCommits {
-		c := gitCommit.GetCommit()
+	written := 0
+	for checkpoint.Status != models.BackfillCompleted {
+		var commits []*models.Commit
+		response, err := g.callFetcher(ctx, filters.Host, filters.Fetcher, 1, func(src source.CommitSource) (response *source.Response, err error) {
+			commits, response, err = src.ListCommits(ctx, filters.OwnerAndRepoName, options)
+			return response, err
+		})
+		if err != nil {
+			useLogger.WithError(err).Error("failed to list commits from source")
+			return written, err
+		}
 
-		commit := &models.Commit{
-			SHA:             gitCommit.GetSHA(),
-			Message:         c.GetMessage(),
-			Author:          c.GetAuthor().GetName(),
-			Date:            c.Committer.GetDate().Time,
-			URL:             gitCommit.GetHTMLURL(),
-			OwnerName:       filters.OwnerName,
-			RepoName:        filters.RepoName,
-			ParentCommitIDs: make([]string, 0),
+		for _, commit := range commits {
+			isNew, err := g.saveCommitOnBranch(ctx, commit, branch, filters.Fetcher)
+			if err != nil {
+				useLogger.WithError(err).Errorln("error saving commit to storage.")
+				return written, err
+			}
+			if isNew {
+				checkpoint.CommitsWritten++
+				written++
+			}
 		}
 
-		parents := gitCommit.Parents
-		for _, parent := range parents {
-			commit.ParentCommitIDs = append(commit.ParentCommitIDs, parent.GetSHA())
+		checkpoint.NextPage = response.NextPage
+		checkpoint.NextCursor = response.NextCursor
+		if response.NextPage == 0 {
+			checkpoint.Status = models.BackfillCompleted
 		}
+		checkpoint.UpdatedAt = time.Now()
 
-		if err := g.dataStore.SaveCommit(ctx, commit); err != nil {
-			useLogger.WithError(err).Errorln("error saving commit to storage.")
-			return err
+		if err := g.dataStore.SaveBackfillCheckpoint(ctx, checkpoint); err != nil {
+			useLogger.WithError(err).Errorln("failed to save backfill checkpoint")
+			return written, err
 		}
+
+		options.Page = response.NextPage
+		options.Cursor = response.NextCursor
 	}
 
-	if response.NextPage != 0 {
-		ghOptions.Page = response.NextPage
-		goto run
+	useLogger.WithField("commitsWritten", checkpoint.CommitsWritten).Info("backfill completed")
+	return written, nil
+}
+
+// GetPendingBackfills returns the checkpoints of backfills that have not run to completion.
+func (g GitBeamService) GetPendingBackfills(ctx context.Context, owner models.OwnerAndRepoName) []*models.BackfillCheckpoint {
+	checkpoints, _ := g.dataStore.ListBackfillCheckpoints(ctx, owner)
+
+	pending := make([]*models.BackfillCheckpoint, 0)
+	for _, checkpoint := range checkpoints {
+		if checkpoint.Status != models.BackfillCompleted {
+			pending = append(pending, checkpoint)
+		}
 	}
 
-	return nil
+	return pending
 }
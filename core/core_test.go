@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"gitbeam.baselib/store"
+	"gitbeam.commit.monitor/mocks"
+	"gitbeam.commit.monitor/models"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestService(t *testing.T, dataStore *mocks.MockDataStore, hosts ...models.SourceHost) *GitBeamService {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	service, err := NewGitBeamService(logger, store.NewEventStore(logger), dataStore, nil, hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+// testClock is a clock that only moves when told to.
+type testClock struct {
+	now time.Time
+	mu  sync.Mutex
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestFetchAndSaveCommitsCheckpointReadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	service := newTestService(t, dataStore, models.SourceHost{Provider: models.ProviderGithub})
+
+	readErr := errors.New("disk I/O error")
+	dataStore.EXPECT().GetBackfillCheckpoint(gomock.Any(), gomock.Any(), "main").Return(nil, readErr)
+
+	// Nothing may be listed from the source, the mock fails the test on any other call.
+	err := service.FetchAndSaveCommits(context.Background(), models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+	})
+	if !errors.Is(err, readErr) {
+		t.Fatalf("FetchAndSaveCommits() error = %v, want %v", err, readErr)
+	}
+}
+
+func TestFetchAndSaveCommitsCompletedCheckpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	service := newTestService(t, dataStore, models.SourceHost{Provider: models.ProviderGithub})
+
+	dataStore.EXPECT().GetBackfillCheckpoint(gomock.Any(), gomock.Any(), "main").Return(&models.BackfillCheckpoint{
+		Branch: "main",
+		Status: models.BackfillCompleted,
+	}, nil)
+
+	err := service.FetchAndSaveCommits(context.Background(), models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+	})
+	if err != nil {
+		t.Fatalf("FetchAndSaveCommits() error = %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gitbeam.commit.monitor/events/topics"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// checkHistoryRewrite verifies that the branch head we mirrored last is still an ancestor of the new head.
+// When it isn't, the incident is recorded with the mirrored commits the branch no longer reaches, and published.
+func (g GitBeamService) checkHistoryRewrite(ctx context.Context, name models.OwnerAndRepoName, branch, oldHead, newHead string) (*models.HistoryRewrite, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "checkHistoryRewrite")
+	if oldHead == "" || oldHead == newHead {
+		return nil, nil
+	}
+
+	var fastForward bool
+	_, err := g.callSource(ctx, name.Host, func(src source.CommitSource) (response *source.Response, err error) {
+		fastForward, response, err = src.IsAncestor(ctx, name, oldHead, newHead)
+		return response, err
+	})
+
+	switch {
+	case errors.Is(err, source.ErrNotFound):
+		// The old head has been garbage collected, so nothing reaches it anymore.
+	case err != nil:
+		useLogger.WithError(err).Error("failed to compare branch heads on source")
+		return nil, err
+	case fastForward:
+		return nil, nil
+	}
+
+	// The new history has just been mirrored, so the stored parent graph tells what only the old head reached.
+	orphaned, err := g.dataStore.ListCommitsBetween(ctx, name, newHead, oldHead)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list orphaned commits from database")
+		return nil, err
+	}
+
+	rewrite := &models.HistoryRewrite{
+		DetectedAt:   time.Now(),
+		Host:         name.Host,
+		OwnerName:    name.OwnerName,
+		RepoName:     name.RepoName,
+		Branch:       branch,
+		OldHeadSHA:   oldHead,
+		NewHeadSHA:   newHead,
+		OrphanedSHAs: make([]string, 0, len(orphaned)),
+	}
+
+	for _, commit := range orphaned {
+		rewrite.OrphanedSHAs = append(rewrite.OrphanedSHAs, commit.SHA)
+	}
+
+	if err = g.dataStore.SaveHistoryRewrite(ctx, rewrite); err != nil {
+		useLogger.WithError(err).Errorln("failed to save history rewrite")
+		return nil, err
+	}
+
+	useLogger.WithFields(logrus.Fields{
+		"ownerName":    name.OwnerName,
+		"repoName":     name.RepoName,
+		"branch":       branch,
+		"oldHeadSha":   oldHead,
+		"newHeadSha":   newHead,
+		"orphanedShas": len(rewrite.OrphanedSHAs),
+	}).Warn("branch history was rewritten")
+
+	data, _ := json.Marshal(rewrite)
+	_ = g.eventStore.Publish(topics.HistoryRewritten, data)
+	return rewrite, nil
+}
+
+func (g GitBeamService) ListHistoryRewrites(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.HistoryRewrite, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListHistoryRewrites")
+
+	list, err := g.dataStore.ListHistoryRewrites(ctx, owner)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list history rewrites from database")
+		return make([]*models.HistoryRewrite, 0), nil
+	}
+
+	return list, nil
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitbeam.commit.monitor/mocks"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCheckHistoryRewrite(t *testing.T) {
+	owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+
+	tests := []struct {
+		name         string
+		oldHead      string
+		status       int    // Status of the comparison of the old head with the new one on the source, 0 when not compared.
+		comparison   string // Status of the new head relative to the old one, as reported by GitHub.
+		wantOrphaned string // SHAs recorded as orphaned, empty when no rewrite is recorded.
+		wantErr      error
+	}{
+		{"first sync", "", 0, "", "", nil},
+		{"same head", "new", 0, "", "", nil},
+		{"fast-forward", "old", http.StatusOK, "ahead", "", nil},
+		{"force push", "old", http.StatusOK, "diverged", "[old parent]", nil},
+		{"reset to an ancestor", "old", http.StatusOK, "behind", "[old parent]", nil},
+		{"old head garbage collected", "old", http.StatusNotFound, "", "[old parent]", nil},
+		{"comparison denied", "old", http.StatusForbidden, "", "", source.ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/repos/o/r/compare/old...new" || tt.status == 0 {
+					t.Errorf("unexpected call to %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprintf(w, `{"status":%q,"message":"m"}`, tt.comparison)
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			dataStore := mocks.NewMockDataStore(ctrl)
+			service := newTestService(t, dataStore, models.SourceHost{
+				Provider: models.ProviderGithub,
+				Name:     "ghe",
+				BaseURL:  server.URL + "/api/v3/",
+			})
+
+			if tt.wantOrphaned != "" {
+				// Orphans are what the old head reaches and the new one doesn't.
+				dataStore.EXPECT().ListCommitsBetween(gomock.Any(), owner, "new", "old").Return([]*models.Commit{
+					{SHA: "old"}, {SHA: "parent"},
+				}, nil)
+				dataStore.EXPECT().SaveHistoryRewrite(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			rewrite, err := service.checkHistoryRewrite(context.Background(), owner, "main", tt.oldHead, "new")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkHistoryRewrite() error = %v, want %v", err, tt.wantErr)
+			}
+
+			switch {
+			case tt.wantOrphaned == "" && rewrite != nil:
+				t.Errorf("checkHistoryRewrite() = %+v, want no rewrite", rewrite)
+			case tt.wantOrphaned != "" && rewrite == nil:
+				t.Errorf("checkHistoryRewrite() = nil, want a rewrite orphaning %s", tt.wantOrphaned)
+			case rewrite != nil:
+				if got := fmt.Sprint(rewrite.OrphanedSHAs); got != tt.wantOrphaned || rewrite.OldHeadSHA != "old" ||
+					rewrite.NewHeadSHA != "new" || rewrite.Branch != "main" || rewrite.Host != "ghe" {
+					t.Errorf("checkHistoryRewrite() = %+v, want %s orphaned", rewrite, tt.wantOrphaned)
+				}
+			}
+		})
+	}
+}
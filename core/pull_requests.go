@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+// ensureCommitPullRequests fetches the pull requests of a commit, unless they were fetched for it before.
+func (g GitBeamService) ensureCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) error {
+	if fetched, _ := g.dataStore.HasCommitPullRequests(ctx, owner, sha); fetched {
+		return nil
+	}
+
+	var pullRequests []*models.PullRequest
+	_, err := g.callSource(ctx, owner.Host, func(src source.CommitSource) (response *source.Response, err error) {
+		pullRequests, response, err = src.ListCommitPullRequests(ctx, owner, sha)
+		return response, err
+	})
+	if err != nil {
+		return err
+	}
+
+	return g.dataStore.SaveCommitPullRequests(ctx, owner, sha, pullRequests)
+}
+
+// ListCommitsForPullRequest lists the mirrored commits of a pull request, oldest first. Commits backfilled through
+// the REST fetcher outside of enrichmentWindow only count once they were asked for, see GetCommitsBySha.
+func (g GitBeamService) ListCommitsForPullRequest(ctx context.Context, owner models.OwnerAndRepoName, number int) ([]*models.Commit, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListCommitsForPullRequest")
+	commits, err := g.dataStore.ListPullRequestCommits(ctx, owner, number)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list pull request commits from database")
+		return nil, err
+	}
+
+	if commits == nil {
+		commits = make([]*models.Commit, 0)
+	}
+
+	return commits, nil
+}
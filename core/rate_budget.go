@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+// rateBudget is the single gate every call to a source host goes through, shared by all monitoring jobs.
+// Callers are served in the order they arrived; when every token is exhausted or parked by a
+// secondary rate limit they wait in the queue until a token resets or their context is done.
+type rateBudget struct {
+	logger *logrus.Logger
+	timer  *time.Timer
+	now    func() time.Time
+	tokens []*sourceToken
+	queue  []chan *sourceToken
+	mu     sync.Mutex
+}
+
+func newRateBudget(logger *logrus.Logger, tokens []*sourceToken) *rateBudget {
+	return &rateBudget{
+		logger: logger,
+		now:    time.Now,
+		tokens: tokens,
+	}
+}
+
+// acquire hands out the token with the most remaining budget, waiting in line when none is available.
+// The budget of the given number of calls is reserved on it.
+func (b *rateBudget) acquire(ctx context.Context, calls int) (*sourceToken, error) {
+	b.mu.Lock()
+	if len(b.queue) == 0 {
+		if token := b.pick(b.now()); token != nil {
+			token.reserve(calls)
+			b.mu.Unlock()
+			return token, nil
+		}
+	}
+
+	waiter := make(chan *sourceToken, 1)
+	b.queue = append(b.queue, waiter)
+	b.scheduleWakeUp()
+	b.mu.Unlock()
+
+	select {
+	case token := <-waiter:
+		if calls > 1 {
+			// Tokens are handed out with the budget of a single call reserved.
+			b.mu.Lock()
+			token.reserve(calls - 1)
+			b.mu.Unlock()
+		}
+		return token, nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if !b.dequeue(waiter) {
+			// A token was handed to us as ctx finished, pass it on to the next caller.
+			(<-waiter).unreserve(1)
+		}
+		b.dispatch()
+		return nil, ctx.Err()
+	}
+}
+
+// release feeds the outcome of a call made with token back into the budget.
+func (b *rateBudget) release(token *sourceToken, response *source.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	token.observe(response, err, now)
+	if token.exhausted() || token.blockedUntil.After(now) {
+		b.logger.WithFields(logrus.Fields{
+			"token":        token.name,
+			"reset":        token.reset,
+			"blockedUntil": token.blockedUntil,
+		}).Info("source token is out of budget")
+	}
+
+	b.dispatch()
+}
+
+// pick returns the best available token, preferring ones we have no rate limit data on yet.
+func (b *rateBudget) pick(now time.Time) *sourceToken {
+	var best *sourceToken
+	for _, token := range b.tokens {
+		if token.availableAt(now).After(now) {
+			continue
+		}
+
+		if best == nil || best.remaining != -1 && (token.remaining == -1 || token.remaining > best.remaining) {
+			best = token
+		}
+	}
+	return best
+}
+
+// dispatch hands available tokens to queued callers in order. Must be called with mu held.
+func (b *rateBudget) dispatch() {
+	now := b.now()
+	for len(b.queue) > 0 {
+		token := b.pick(now)
+		if token == nil {
+			break
+		}
+
+		token.reserve(1)
+		b.queue[0] <- token
+		b.queue = b.queue[1:]
+	}
+
+	b.scheduleWakeUp()
+}
+
+// scheduleWakeUp arms a timer for the earliest time a token frees up while callers are waiting.
+// Must be called with mu held.
+func (b *rateBudget) scheduleWakeUp() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.queue) == 0 {
+		return
+	}
+
+	now := b.now()
+	var earliest time.Time
+	for _, token := range b.tokens {
+		if at := token.availableAt(now); earliest.IsZero() || at.Before(earliest) {
+			earliest = at
+		}
+	}
+
+	b.timer = time.AfterFunc(earliest.Sub(now), func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.dispatch()
+	})
+}
+
+// dequeue removes waiter from the queue, reporting false when it was already served. Must be called with mu held.
+func (b *rateBudget) dequeue(waiter chan *sourceToken) bool {
+	for i, w := range b.queue {
+		if w == waiter {
+			b.queue = append(b.queue[:i], b.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
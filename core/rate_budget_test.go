@@ -0,0 +1,197 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+)
+
+// newTestRateBudget returns a budget of a single token on clock, which has no calls left until its reset in an hour.
+func newTestRateBudget(clock *testClock) (*rateBudget, *sourceToken) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	token := &sourceToken{name: "****abcd", remaining: 0, reset: clock.Now().Add(time.Hour), cost: 1}
+	budget := newRateBudget(logger, []*sourceToken{token})
+	budget.now = clock.Now
+	return budget, token
+}
+
+// queued returns how many callers wait for a token.
+func (b *rateBudget) queued() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.queue)
+}
+
+// wakeUp does what the timer of the budget does once the earliest token frees up.
+func (b *rateBudget) wakeUp() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dispatch()
+}
+
+// waitInLine starts a caller named name acquiring from b, which reports its name on served once it got a token.
+// It returns once the caller is queued.
+func waitInLine(t *testing.T, ctx context.Context, b *rateBudget, name string, served chan<- string) <-chan error {
+	t.Helper()
+	queued, done := b.queued(), make(chan error, 1)
+	go func() {
+		_, err := b.acquire(ctx, 1)
+		if err == nil {
+			served <- name
+		}
+		done <- err
+	}()
+
+	for deadline := time.Now().Add(time.Second); b.queued() == queued; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s never waited in line", name)
+		}
+	}
+	return done
+}
+
+// nextServed returns the name of the next caller served, or "" when none is within a short wait.
+func nextServed(served <-chan string) string {
+	select {
+	case name := <-served:
+		return name
+	case <-time.After(50 * time.Millisecond):
+		return ""
+	}
+}
+
+// oneCallLeft is the response of a host that has the budget of one more call left on the token.
+func oneCallLeft(clock *testClock) *source.Response {
+	return &source.Response{Rate: source.RateLimit{Limit: 5000, Remaining: 1, Reset: clock.Now().Add(time.Hour)}}
+}
+
+func TestRateBudgetServesInOrder(t *testing.T) {
+	clock := newTestClock()
+	budget, token := newTestRateBudget(clock)
+	served := make(chan string, 3)
+
+	for _, name := range []string{"first", "second", "third"} {
+		waitInLine(t, context.Background(), budget, name, served)
+	}
+
+	for _, want := range []string{"first", "second", "third"} {
+		budget.release(token, oneCallLeft(clock), nil)
+		if got := nextServed(served); got != want {
+			t.Fatalf("served %q, want %q", got, want)
+		}
+		if got := nextServed(served); got != "" {
+			t.Fatalf("served %q too, want a single caller per call left", got)
+		}
+	}
+}
+
+func TestRateBudgetCancelledWaiter(t *testing.T) {
+	clock := newTestClock()
+	budget, token := newTestRateBudget(clock)
+	served := make(chan string, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := waitInLine(t, ctx, budget, "cancelled", served)
+	waitInLine(t, context.Background(), budget, "next", served)
+
+	cancel()
+	if err := <-cancelled; !errors.Is(err, context.Canceled) {
+		t.Fatalf("acquire() error = %v, want %v", err, context.Canceled)
+	}
+
+	budget.release(token, oneCallLeft(clock), nil)
+	if got := nextServed(served); got != "next" {
+		t.Errorf("served %q, want the caller behind the cancelled one", got)
+	}
+}
+
+func TestRateBudgetHandsOffTokenOfCancelledWaiter(t *testing.T) {
+	// The token may reach the cancelled caller as it gives up, which must pass it on rather than lose it.
+	for i := 0; i < 50; i++ {
+		clock := newTestClock()
+		budget, token := newTestRateBudget(clock)
+		served := make(chan string, 2)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := waitInLine(t, ctx, budget, "cancelled", served)
+		waitInLine(t, context.Background(), budget, "next", served)
+
+		budget.mu.Lock()
+		cancel()
+		token.observe(oneCallLeft(clock), nil, clock.Now())
+		budget.dispatch()
+		budget.mu.Unlock()
+
+		if err := <-cancelled; err == nil {
+			continue // It took the token before noticing it was cancelled.
+		}
+		if got := nextServed(served); got != "next" {
+			t.Fatalf("served %q, want the token handed on to the next caller", got)
+		}
+	}
+}
+
+func TestRateBudgetParksTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *source.Response
+		err      error
+		parked   time.Duration
+	}{
+		{"out of budget", nil, &source.RateLimitError{Reset: newTestClock().Now().Add(time.Hour)}, time.Hour},
+		{"secondary rate limit", nil, &source.RateLimitError{RetryAfter: 30 * time.Second}, 30 * time.Second},
+		{"secondary rate limit without retry after", nil, &source.RateLimitError{}, defaultSecondaryRateLimitWait},
+		{"retry after", &source.Response{RetryAfter: 10 * time.Second}, nil, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			budget, token := newTestRateBudget(clock)
+			token.remaining, token.reset = -1, time.Time{}
+
+			acquired, err := budget.acquire(context.Background(), 1)
+			if err != nil || acquired != token {
+				t.Fatalf("acquire() = %v, %v, want the token", acquired, err)
+			}
+			budget.release(token, tt.response, tt.err)
+
+			served := make(chan string, 1)
+			waitInLine(t, context.Background(), budget, "caller", served)
+
+			clock.advance(tt.parked - time.Second)
+			budget.wakeUp()
+			if got := nextServed(served); got != "" {
+				t.Fatalf("served %q a second before the token frees up", got)
+			}
+
+			clock.advance(time.Second)
+			budget.wakeUp()
+			if got := nextServed(served); got != "caller" {
+				t.Errorf("served %q once the token freed up, want the caller", got)
+			}
+		})
+	}
+}
+
+func TestRateBudgetWakesUpWaiters(t *testing.T) {
+	budget, token := newTestRateBudget(newTestClock())
+	budget.now = time.Now
+	token.remaining, token.reset = -1, time.Time{}
+	token.blockedUntil = time.Now().Add(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Nothing but the timer of the budget hands out the token once it is free again.
+	if acquired, err := budget.acquire(ctx, 1); err != nil || acquired != token {
+		t.Errorf("acquire() = %v, %v, want the token once it frees up", acquired, err)
+	}
+}
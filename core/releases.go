@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+var (
+	ErrRefNotFound     = errors.New("ref is neither a mirrored tag, branch nor commit")
+	ErrCommitsRangeEnd = errors.New("toRef is required")
+)
+
+// SyncReleases mirrors the tags and releases of a repository, replacing those of the last sync.
+func (g GitBeamService) SyncReleases(ctx context.Context, name models.OwnerAndRepoName) error {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "SyncReleases")
+
+	tags := make([]*models.Tag, 0)
+	for page := 1; page != 0; {
+		var pageTags []*models.Tag
+		response, err := g.callSource(ctx, name.Host, func(src source.CommitSource) (response *source.Response, err error) {
+			pageTags, response, err = src.ListTags(ctx, name, page)
+			return response, err
+		})
+		if err != nil {
+			useLogger.WithError(err).Error("failed to list tags from source")
+			return err
+		}
+
+		tags = append(tags, pageTags...)
+		page = response.NextPage
+	}
+
+	releases := make([]*models.Release, 0)
+	for page := 1; page != 0; {
+		var pageReleases []*models.Release
+		response, err := g.callSource(ctx, name.Host, func(src source.CommitSource) (response *source.Response, err error) {
+			pageReleases, response, err = src.ListReleases(ctx, name, page)
+			return response, err
+		})
+		if err != nil {
+			useLogger.WithError(err).Error("failed to list releases from source")
+			return err
+		}
+
+		releases = append(releases, pageReleases...)
+		page = response.NextPage
+	}
+
+	if err := g.dataStore.SaveTags(ctx, name, tags); err != nil {
+		useLogger.WithError(err).Errorln("failed to save tags")
+		return err
+	}
+
+	if err := g.dataStore.SaveReleases(ctx, name, releases); err != nil {
+		useLogger.WithError(err).Errorln("failed to save releases")
+		return err
+	}
+
+	return nil
+}
+
+func (g GitBeamService) ListReleases(ctx context.Context, name models.OwnerAndRepoName) ([]*models.Release, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListReleases")
+	releases, err := g.dataStore.ListReleases(ctx, name)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list releases from database")
+		return nil, err
+	}
+
+	return releases, nil
+}
+
+// ListCommitsBetween returns the commits reachable from toRef but not from fromRef, newest first, like
+// git log fromRef..toRef over the mirrored history. Refs are tags, mirrored branches or commit SHAs, in that order.
+// An empty fromRef returns all of toRef's mirrored history.
+func (g GitBeamService) ListCommitsBetween(ctx context.Context, name models.OwnerAndRepoName, fromRef, toRef string) ([]*models.Commit, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListCommitsBetween")
+	if toRef == "" {
+		return nil, ErrCommitsRangeEnd
+	}
+
+	toSHA, err := g.resolveRef(ctx, name, toRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromSHA string
+	if fromRef != "" {
+		if fromSHA, err = g.resolveRef(ctx, name, fromRef); err != nil {
+			return nil, err
+		}
+	}
+
+	commits, err := g.dataStore.ListCommitsBetween(ctx, name, fromSHA, toSHA)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list commits between refs from database")
+		return nil, err
+	}
+
+	if commits == nil {
+		commits = make([]*models.Commit, 0)
+	}
+
+	return commits, nil
+}
+
+// resolveRef returns the SHA of the mirrored commit ref names.
+func (g GitBeamService) resolveRef(ctx context.Context, name models.OwnerAndRepoName, ref string) (string, error) {
+	if tag, _ := g.dataStore.GetTag(ctx, name, ref); tag != nil {
+		return tag.SHA, nil
+	}
+
+	if cursor, _ := g.dataStore.GetSyncCursor(ctx, name, ref); cursor != nil {
+		return cursor.HeadSHA, nil
+	}
+
+	if commit, _ := g.dataStore.GetCommitBySHA(ctx, name, ref); commit != nil {
+		return commit.SHA, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrRefNotFound, ref)
+}
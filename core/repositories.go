@@ -0,0 +1,177 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gitbeam.commit.monitor/events/topics"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+var ErrRepositoryNotFound = errors.New("repository not found")
+
+// RefreshRepository mirrors the metadata of a repository from its source, publishing RepoCreated the first time
+// it is mirrored or when it shows up again after being deleted. Once the source no longer has the repository,
+// it is kept marked as deleted, RepoDeleted is published and source.ErrNotFound returned.
+//
+// Repositories are followed by their source ID, sourceID when known to the caller, 0 otherwise. A repository
+// renamed or transferred is returned under its new name without being mirrored: callers record its source ID
+// before moving what we store of it with RenameRepository, so that a failed move can be picked up again.
+func (g GitBeamService) RefreshRepository(ctx context.Context, name models.OwnerAndRepoName, sourceID int64) (*models.Repository, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "RefreshRepository").WithFields(logrus.Fields{
+		"ownerName": name.OwnerName,
+		"repoName":  name.RepoName,
+	})
+
+	previous, _ := g.dataStore.GetRepository(ctx, name)
+	if sourceID == 0 && previous != nil {
+		sourceID = previous.SourceID
+	}
+
+	repo, err := g.getSourceRepository(ctx, name, sourceID)
+	switch {
+	case errors.Is(err, source.ErrNotFound):
+		if previous != nil && previous.DeletedAt == nil {
+			now := time.Now()
+			previous.DeletedAt = &now
+			if saveErr := g.dataStore.SaveRepository(ctx, previous); saveErr != nil {
+				useLogger.WithError(saveErr).Errorln("failed to mark repository as deleted")
+				return nil, saveErr
+			}
+
+			useLogger.Warn("repository was deleted on source")
+			g.publishRepository(topics.RepoDeleted, previous)
+		}
+		return nil, err
+	case err != nil:
+		useLogger.WithError(err).Error("failed to get repository from source")
+		return nil, err
+	}
+
+	if repo.OwnerAndRepoName() != name {
+		useLogger.WithFields(logrus.Fields{
+			"newOwnerName": repo.OwnerName,
+			"newRepoName":  repo.RepoName,
+		}).Info("repository was renamed on source")
+		return repo, nil
+	}
+
+	if err = g.saveRepository(ctx, repo, previous); err != nil {
+		useLogger.WithError(err).Errorln("failed to save repository")
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// RenameRepository moves everything stored of the repository from to the name of repo, as refreshed from its source,
+// and publishes RepoRenamed. Moving a repository twice is harmless, the event is only published the first time.
+func (g GitBeamService) RenameRepository(ctx context.Context, from models.OwnerAndRepoName, repo *models.Repository) error {
+	to := repo.OwnerAndRepoName()
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "RenameRepository").WithFields(logrus.Fields{
+		"ownerName":    from.OwnerName,
+		"repoName":     from.RepoName,
+		"newOwnerName": to.OwnerName,
+		"newRepoName":  to.RepoName,
+	})
+
+	previous, _ := g.dataStore.GetRepository(ctx, from)
+	if err := g.dataStore.RenameRepository(ctx, from, to); err != nil {
+		useLogger.WithError(err).Errorln("failed to move repository to its new name")
+		return err
+	}
+
+	// Nothing was stored under the old name when the repository was renamed before we first mirrored it,
+	// or when it was moved before.
+	if previous != nil {
+		useLogger.Info("moved repository to its new name")
+		data, _ := json.Marshal(models.RepositoryRename{
+			RenamedAt: time.Now(),
+			From:      from,
+			To:        to,
+			SourceID:  repo.SourceID,
+		})
+		_ = g.eventStore.Publish(topics.RepoRenamed, data)
+	}
+
+	previous, _ = g.dataStore.GetRepository(ctx, to)
+	if err := g.saveRepository(ctx, repo, previous); err != nil {
+		useLogger.WithError(err).Errorln("failed to save repository")
+		return err
+	}
+
+	return nil
+}
+
+// saveRepository stores repo as synced now, publishing RepoCreated unless we mirrored it as previous.
+func (g GitBeamService) saveRepository(ctx context.Context, repo, previous *models.Repository) error {
+	repo.SyncedAt = time.Now()
+	if err := g.dataStore.SaveRepository(ctx, repo); err != nil {
+		return err
+	}
+
+	if previous == nil || previous.DeletedAt != nil {
+		g.logger.WithContext(ctx).WithField("methodName", "saveRepository").WithFields(logrus.Fields{
+			"ownerName": repo.OwnerName,
+			"repoName":  repo.RepoName,
+		}).Info("mirroring new repository")
+		g.publishRepository(topics.RepoCreated, repo)
+	}
+
+	return nil
+}
+
+// getSourceRepository gets the repository by name, falling back to its source ID once the name leads nowhere or to
+// another repository, e.g. when it was transferred and its old name taken since.
+func (g GitBeamService) getSourceRepository(ctx context.Context, name models.OwnerAndRepoName, sourceID int64) (*models.Repository, error) {
+	var repo *models.Repository
+	_, err := g.callSource(ctx, name.Host, func(src source.CommitSource) (response *source.Response, err error) {
+		repo, response, err = src.GetRepository(ctx, name)
+		return response, err
+	})
+
+	if sourceID == 0 {
+		return repo, err
+	}
+
+	if !errors.Is(err, source.ErrNotFound) && (err != nil || repo.SourceID == sourceID) {
+		return repo, err
+	}
+
+	_, err = g.callSource(ctx, name.Host, func(src source.CommitSource) (response *source.Response, err error) {
+		repo, response, err = src.GetRepositoryByID(ctx, name.Host, sourceID)
+		return response, err
+	})
+	return repo, err
+}
+
+func (g GitBeamService) publishRepository(topic string, repo *models.Repository) {
+	data, _ := json.Marshal(repo)
+	_ = g.eventStore.Publish(topic, data)
+}
+
+func (g GitBeamService) GetRepository(ctx context.Context, name models.OwnerAndRepoName) (*models.Repository, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetRepository")
+	repo, err := g.dataStore.GetRepository(ctx, name)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to fetch repository from the dataStore")
+		return nil, ErrRepositoryNotFound
+	}
+
+	return repo, nil
+}
+
+// ListRepositories lists every repository we mirror or have mirrored, those deleted on their source included.
+func (g GitBeamService) ListRepositories(ctx context.Context) ([]*models.Repository, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "ListRepositories")
+	list, err := g.dataStore.ListRepositories(ctx)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list repositories from database")
+		return nil, err
+	}
+
+	return list, nil
+}
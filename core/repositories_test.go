@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gitbeam.baselib/store"
+	"gitbeam.commit.monitor/events/topics"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/repository"
+	"gitbeam.commit.monitor/repository/sqlite"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+)
+
+// githubRepositories stands in for the repositories API of a GitHub host, answering with the JSON stored by path.
+type githubRepositories struct {
+	byPath map[string]string
+	mu     sync.Mutex
+}
+
+func (g *githubRepositories) set(path, body string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if body == "" {
+		delete(g.byPath, path)
+		return
+	}
+	g.byPath[path] = body
+}
+
+func (g *githubRepositories) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	body, ok := g.byPath[r.URL.Path]
+	g.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+		return
+	}
+	fmt.Fprint(w, body)
+}
+
+func newRenameTestService(t *testing.T) (*GitBeamService, repository.DataStore, *githubRepositories, chan models.RepositoryRename) {
+	t.Helper()
+	repos := &githubRepositories{byPath: make(map[string]string)}
+	server := httptest.NewServer(repos)
+	t.Cleanup(server.Close)
+
+	dataStore, err := sqlite.NewSqliteRepo(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	eventStore := store.NewEventStore(logger)
+	renames := make(chan models.RepositoryRename, 4)
+	if err := eventStore.Subscribe(topics.RepoRenamed, func(event store.Event) error {
+		var rename models.RepositoryRename
+		err := json.Unmarshal(event.Data(), &rename)
+		renames <- rename
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := NewGitBeamService(logger, eventStore, dataStore, nil, []models.SourceHost{
+		{Provider: models.ProviderGithub, Name: "ghe", BaseURL: server.URL + "/api/v3/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service, dataStore, repos, renames
+}
+
+func TestRefreshRepositoryFollowsRenames(t *testing.T) {
+	service, dataStore, repos, renames := newRenameTestService(t)
+	ctx := context.Background()
+	old := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+	renamed := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o2", RepoName: "r2"}
+
+	repos.set("/api/v3/repos/o/r", `{"id":7,"name":"r","owner":{"login":"o"},"default_branch":"main"}`)
+	repo, err := service.RefreshRepository(ctx, old, 0)
+	if err != nil || repo.SourceID != 7 || repo.OwnerAndRepoName() != old {
+		t.Fatalf("RefreshRepository() = %+v, %v", repo, err)
+	}
+
+	if err := dataStore.SaveCommit(ctx, &models.Commit{Date: time.Now(), Host: "ghe", OwnerName: "o", RepoName: "r", SHA: "s1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// GitHub redirects the old name, the repository is returned under the new one without being moved yet.
+	repos.set("/api/v3/repos/o/r", `{"id":7,"name":"r2","owner":{"login":"o2"},"default_branch":"main"}`)
+	if repo, err = service.RefreshRepository(ctx, old, 7); err != nil || repo.OwnerAndRepoName() != renamed {
+		t.Fatalf("RefreshRepository() = %+v, %v, want it under %v", repo, err, renamed)
+	}
+	if commit, _ := dataStore.GetCommitBySHA(ctx, old, "s1"); commit == nil {
+		t.Fatal("commit moved before RenameRepository")
+	}
+
+	if err := service.RenameRepository(ctx, old, repo); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case rename := <-renames:
+		if rename.From != old || rename.To != renamed || rename.SourceID != 7 {
+			t.Errorf("RepoRenamed = %+v", rename)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("RepoRenamed was not published")
+	}
+
+	if commit, _ := dataStore.GetCommitBySHA(ctx, renamed, "s1"); commit == nil {
+		t.Error("commit was not moved to the new name")
+	}
+	if list, _ := service.ListRepositories(ctx); len(list) != 1 || list[0].OwnerAndRepoName() != renamed {
+		t.Errorf("ListRepositories() = %v, want the repository under its new name only", list)
+	}
+
+	// Moving it again, e.g. after the monitor failed to follow, doesn't publish the rename twice.
+	if err := service.RenameRepository(ctx, old, repo); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case rename := <-renames:
+		t.Errorf("RepoRenamed published twice: %+v", rename)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRefreshRepositoryFollowsSourceID(t *testing.T) {
+	service, _, repos, _ := newRenameTestService(t)
+	ctx := context.Background()
+	old := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+
+	// The old name was taken by another repository after the transfer, nothing was stored of ours under it yet.
+	repos.set("/api/v3/repos/o/r", `{"id":9,"name":"r","owner":{"login":"o"}}`)
+	repos.set("/api/v3/repositories/7", `{"id":7,"name":"r3","owner":{"login":"o3"}}`)
+
+	repo, err := service.RefreshRepository(ctx, old, 7)
+	if err != nil || repo.SourceID != 7 || repo.OwnerName != "o3" || repo.RepoName != "r3" {
+		t.Fatalf("RefreshRepository() = %+v, %v, want the repository with source ID 7", repo, err)
+	}
+
+	// Without its source ID the name is all we can go by.
+	if repo, err = service.RefreshRepository(ctx, old, 0); err != nil || repo.SourceID != 9 {
+		t.Fatalf("RefreshRepository() = %+v, %v, want the repository now named %v", repo, err, old)
+	}
+
+	repos.set("/api/v3/repositories/7", "")
+	if _, err = service.RefreshRepository(ctx, old, 7); !errors.Is(err, source.ErrNotFound) {
+		t.Fatalf("RefreshRepository() error = %v, want %v", err, source.ErrNotFound)
+	}
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"gitbeam.commit.monitor/source"
+	"math/rand"
+	"time"
+)
+
+// Calls that fail on a transient error are made up to sourceCallAttempts times in total, backing off exponentially
+// from sourceRetryBaseDelay up to sourceRetryMaxDelay in between.
+const (
+	sourceCallAttempts   = 5
+	sourceRetryBaseDelay = time.Second
+	sourceRetryMaxDelay  = 30 * time.Second
+)
+
+// retryDelay returns how long to wait after attempt failed, counting from 1. Half of the delay is random,
+// so jobs that failed together during an outage don't retry together.
+func retryDelay(attempt int) time.Duration {
+	delay := sourceRetryMaxDelay
+	if attempt < 16 {
+		delay = min(sourceRetryBaseDelay<<(attempt-1), sourceRetryMaxDelay)
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// shouldRetry reports whether a call that failed with err on attempt is worth making again.
+// Running out of rate limit needs no delay of our own, the rate budget holds the next attempt until a token is free.
+func shouldRetry(ctx context.Context, err error, attempt int) (bool, time.Duration) {
+	if err == nil || attempt >= sourceCallAttempts || ctx.Err() != nil || !source.IsTransient(err) {
+		return false, 0
+	}
+
+	var rateLimitErr *source.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true, 0
+	}
+
+	return true, retryDelay(attempt)
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+func TestShouldRetry(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	unavailable := fmt.Errorf("%w: 502 bad gateway", source.ErrUnavailable)
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		err       error
+		attempt   int
+		wantRetry bool
+		wantDelay time.Duration // Longest delay wanted, at least half of it is waited.
+	}{
+		{"success", context.Background(), nil, 1, false, 0},
+		{"server error", context.Background(), unavailable, 1, true, sourceRetryBaseDelay},
+		{"server error backs off", context.Background(), unavailable, 3, true, 4 * sourceRetryBaseDelay},
+		{"secondary rate limit", context.Background(), &source.RateLimitError{RetryAfter: time.Minute}, 1, true, 0},
+		{"rate limit", context.Background(), &source.RateLimitError{Reset: time.Now().Add(time.Hour)}, 1, true, 0},
+		{"not found", context.Background(), source.ErrNotFound, 1, false, 0},
+		{"access denied", context.Background(), source.ErrAccessDenied, 1, false, 0},
+		{"client error", context.Background(), errors.New("422 validation failed"), 1, false, 0},
+		{"last attempt", context.Background(), unavailable, sourceCallAttempts, false, 0},
+		{"cancelled", cancelled, unavailable, 1, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retry, delay := shouldRetry(tt.ctx, tt.err, tt.attempt)
+			if retry != tt.wantRetry || delay < tt.wantDelay/2 || delay > tt.wantDelay {
+				t.Errorf("shouldRetry() = %v, %v, want %v after at most %v", retry, delay, tt.wantRetry, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt := 1; attempt <= 64; attempt++ {
+		want := sourceRetryMaxDelay
+		if attempt <= 5 {
+			want = sourceRetryBaseDelay << (attempt - 1)
+		}
+
+		for i := 0; i < 100; i++ {
+			if delay := retryDelay(attempt); delay < want/2 || delay > want {
+				t.Fatalf("retryDelay(%d) = %v, want between %v and %v", attempt, delay, want/2, want)
+			}
+		}
+	}
+}
+
+func TestCallSourceRetries(t *testing.T) {
+	// Failures the host answers with, the call succeeds once they ran out. A zero failure drops the connection.
+	type failure struct {
+		status int
+		header http.Header
+	}
+	dropped := failure{}
+	outOfBudget := failure{http.StatusForbidden, http.Header{
+		"X-Ratelimit-Limit":     {"5000"},
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {"1700000000"}, // Reset already, the budget doesn't hold the retry.
+	}}
+
+	tests := []struct {
+		name      string
+		failures  []failure
+		wantCalls int
+		wantErr   error
+	}{
+		{"server error", []failure{{status: http.StatusBadGateway}}, 2, nil},
+		{"transport error", []failure{dropped}, 2, nil},
+		{"not found", []failure{{status: http.StatusNotFound}}, 1, source.ErrNotFound},
+		{"access denied", []failure{{status: http.StatusUnauthorized}}, 1, source.ErrAccessDenied},
+		{"client error", []failure{{status: http.StatusUnprocessableEntity}}, 1, nil},
+		{"attempts run out", []failure{outOfBudget, outOfBudget, outOfBudget, outOfBudget, outOfBudget, outOfBudget}, sourceCallAttempts, &source.RateLimitError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if calls > len(tt.failures) {
+					fmt.Fprint(w, `[]`)
+					return
+				}
+
+				failure := tt.failures[calls-1]
+				if failure.status == 0 {
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					conn.Close()
+					return
+				}
+				for key, values := range failure.header {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(failure.status)
+				fmt.Fprint(w, `{"message":"failed"}`)
+			}))
+			defer server.Close()
+
+			service := newTestService(t, nil, models.SourceHost{Provider: models.ProviderGithub, Name: "ghe", BaseURL: server.URL + "/api/v3/"})
+			_, err := service.callSource(context.Background(), "ghe", func(src source.CommitSource) (*source.Response, error) {
+				owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+				_, response, err := src.ListCommits(context.Background(), owner, source.ListCommitsOptions{Page: 1, PerPage: 100})
+				return response, err
+			})
+
+			var rateLimitErr *source.RateLimitError
+			switch {
+			case errors.As(tt.wantErr, &rateLimitErr):
+				if !errors.As(err, &rateLimitErr) {
+					t.Errorf("callSource() error = %v, want a RateLimitError", err)
+				}
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("callSource() error = %v, want %v", err, tt.wantErr)
+			case tt.wantErr == nil && calls > len(tt.failures) && err != nil:
+				t.Errorf("callSource() error = %v, want the retry to succeed", err)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("host was called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCallSourceCancelledDuringBackoff(t *testing.T) {
+	service := newTestService(t, nil, models.SourceHost{Provider: models.ProviderGithub, Name: "ghe"})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	start := time.Now()
+	_, err := service.callSource(ctx, "ghe", func(source.CommitSource) (*source.Response, error) {
+		calls++
+		time.AfterFunc(10*time.Millisecond, cancel) // While the retry is held back.
+		return nil, source.ErrUnavailable
+	})
+
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("callSource() = %v after %d calls, want %v after 1", err, calls, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed >= sourceRetryBaseDelay/2 {
+		t.Errorf("callSource() returned after %v, want it to stop waiting once cancelled", elapsed)
+	}
+}
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"errors"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"net/http"
+	"time"
+)
+
+// defaultSecondaryRateLimitWait is how long a token is parked when a source slows us down without saying for how long.
+const defaultSecondaryRateLimitWait = time.Minute
+
+// sourceToken is a single credential in the rateBudget along with the last rate limit its host reported for it.
+type sourceToken struct {
+	reset        time.Time
+	blockedUntil time.Time // Set by secondary rate limits and Retry-After.
+	source       source.CommitSource
+	name         string // Redacted form of the token, safe for logs.
+	remaining    int    // -1 until the host has reported a rate limit for this token.
+	cost         int    // What a call took off the rate limit last time, GraphQL queries cost more than one.
+}
+
+// newSource creates the source a token makes its calls with, see source.New.
+type newSource func(httpClient *http.Client, host models.SourceHost, token string) (source.CommitSource, error)
+
+// newSourceTokens creates a source for every token of host.
+func newSourceTokens(httpClient *http.Client, host models.SourceHost, newSource newSource) ([]*sourceToken, error) {
+	list := make([]*sourceToken, 0, len(host.Tokens))
+	for _, token := range host.Tokens {
+		commitSource, err := newSource(httpClient, host, token)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, &sourceToken{
+			source:    commitSource,
+			name:      redactToken(token),
+			remaining: -1,
+			cost:      1,
+		})
+	}
+
+	if len(list) == 0 {
+		// Without tokens we fall back to the anonymous quota of the host, e.g. 60 requests per hour on GitHub.
+		commitSource, err := newSource(httpClient, host, "")
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, &sourceToken{
+			source:    commitSource,
+			name:      "anonymous",
+			remaining: -1,
+			cost:      1,
+		})
+	}
+
+	return list, nil
+}
+
+// exhausted reports whether the known budget of the token can't cover another call.
+func (t *sourceToken) exhausted() bool {
+	return t.remaining >= 0 && t.remaining < t.cost
+}
+
+// availableAt returns the earliest time the token can be used again.
+func (t *sourceToken) availableAt(now time.Time) time.Time {
+	if t.exhausted() && now.After(t.reset) {
+		t.remaining = -1 // The window has reset, we no longer know how much is left.
+	}
+
+	at := now
+	if t.exhausted() {
+		at = t.reset
+	}
+
+	if t.blockedUntil.After(at) {
+		at = t.blockedUntil
+	}
+
+	return at
+}
+
+// reserve takes the cost of calls off the known budget so concurrent callers don't overshoot it.
+func (t *sourceToken) reserve(calls int) {
+	if t.remaining > 0 {
+		t.remaining = max(t.remaining-t.cost*calls, 0)
+	}
+}
+
+// unreserve gives back the cost of calls that were reserved but never made.
+func (t *sourceToken) unreserve(calls int) {
+	if t.remaining >= 0 {
+		t.remaining += t.cost * calls
+	}
+}
+
+// observe records the rate limits the host reported for the token on its last call, which returned at now.
+func (t *sourceToken) observe(response *source.Response, err error, now time.Time) {
+	var rateLimitErr *source.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		if !rateLimitErr.Reset.IsZero() {
+			t.remaining = 0
+			t.reset = rateLimitErr.Reset
+			return
+		}
+
+		wait := defaultSecondaryRateLimitWait
+		if rateLimitErr.RetryAfter > 0 {
+			wait = rateLimitErr.RetryAfter
+		}
+		t.blockedUntil = now.Add(wait)
+	}
+
+	if response == nil {
+		return
+	}
+
+	if response.Rate.Limit > 0 {
+		t.remaining = response.Rate.Remaining
+		t.reset = response.Rate.Reset
+	}
+
+	if response.Cost > 0 {
+		t.cost = response.Cost
+	}
+
+	if response.RetryAfter > 0 {
+		if until := now.Add(response.RetryAfter); until.After(t.blockedUntil) {
+			t.blockedUntil = until
+		}
+	}
+}
+
+func redactToken(token string) string {
+	if len(token) <= 4 {
+		return "****"
+	}
+	return "****" + token[len(token)-4:]
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+)
+
+func TestRateBudgetPicksToken(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		tokens []*sourceToken
+		want   string
+	}{
+		{"most remaining", []*sourceToken{
+			{name: "a", remaining: 10, cost: 1},
+			{name: "b", remaining: 20, cost: 1},
+		}, "b"},
+		{"unknown budget first", []*sourceToken{
+			{name: "a", remaining: 4000, cost: 1},
+			{name: "b", remaining: -1, cost: 1},
+		}, "b"},
+		{"exhausted", []*sourceToken{
+			{name: "a", remaining: 0, reset: now.Add(time.Hour), cost: 1},
+			{name: "b", remaining: 1, cost: 1},
+		}, "b"},
+		{"too little for a query", []*sourceToken{
+			{name: "a", remaining: 3, reset: now.Add(time.Hour), cost: 5},
+			{name: "b", remaining: 1, cost: 1},
+		}, "b"},
+		{"rate limited", []*sourceToken{
+			{name: "a", remaining: 100, blockedUntil: now.Add(time.Minute), cost: 1},
+			{name: "b", remaining: 1, cost: 1},
+		}, "b"},
+		{"reset", []*sourceToken{
+			{name: "a", remaining: 0, reset: now.Add(-time.Second), cost: 1},
+			{name: "b", remaining: 0, reset: now.Add(time.Hour), cost: 1},
+		}, "a"},
+		{"none left", []*sourceToken{
+			{name: "a", remaining: 0, reset: now.Add(time.Hour), cost: 1},
+			{name: "b", remaining: 100, blockedUntil: now.Add(time.Minute), cost: 1},
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := newRateBudget(nil, tt.tokens)
+			got := ""
+			if token := budget.pick(now); token != nil {
+				got = token.name
+			}
+			if got != tt.want {
+				t.Errorf("pick() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCallSourceRotatesTokens(t *testing.T) {
+	// Token a runs out on its first call, token b gets slowed down by a secondary rate limit on its second.
+	calls := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		calls = append(calls, token)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		switch {
+		case token == "token-a":
+			w.Header().Set("X-RateLimit-Remaining", "0")
+		case token == "token-b" && len(calls) == 3:
+			w.Header().Set("X-RateLimit-Remaining", "4000")
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+			return
+		default:
+			w.Header().Set("X-RateLimit-Remaining", "4000")
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, nil, models.SourceHost{
+		Provider: models.ProviderGithub,
+		Name:     "ghe",
+		BaseURL:  server.URL + "/api/v3/",
+		Tokens:   []string{"token-a", "token-b", "token-c"},
+	})
+	budget := service.sourceHosts["ghe"].budget
+	for _, token := range budget.tokens[1:] {
+		// b is preferred over c for as long as it has the larger budget.
+		token.remaining = map[string]int{"****en-b": 4000, "****en-c": 3000}[token.name]
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := service.callSource(context.Background(), "ghe", func(src source.CommitSource) (*source.Response, error) {
+			owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+			_, response, err := src.ListCommits(context.Background(), owner, source.ListCommitsOptions{Page: 1, PerPage: 100})
+			return response, err
+		})
+		if err != nil {
+			t.Fatalf("call %d: callSource() error = %v", i, err)
+		}
+	}
+
+	// The secondary rate limit is retried right away with the next token.
+	if got, want := fmt.Sprint(calls), "[token-a token-b token-b token-c]"; got != want {
+		t.Errorf("calls were made with %v, want %v", got, want)
+	}
+}
+
+func TestNewSourceTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []string
+		want   string
+	}{
+		{"anonymous", nil, "[anonymous]"},
+		{"redacted", []string{"ghp_1234567890", "abc"}, "[****7890 ****]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := newSourceTokens(nil, models.SourceHost{Provider: models.ProviderGithub, Tokens: tt.tokens}, source.New)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			names := make([]string, 0, len(tokens))
+			for _, token := range tokens {
+				if token.remaining != -1 || token.source == nil {
+					t.Errorf("token %s = %+v, want a source with an unknown budget", token.name, token)
+				}
+				names = append(names, token.name)
+			}
+			if got := fmt.Sprint(names); got != tt.want {
+				t.Errorf("newSourceTokens() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/source"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// SyncCommits mirrors the commits pushed to a branch of a monitored repository since its last run.
+//
+// Instead of re-listing a date window, it pages from the branch head and stops as soon as the
+// history of every commit new to the branch has reached a commit already recorded on it. Listing is
+// ordered by commit date, so a commit older than the previous head (e.g. from a late merge) is still
+// picked up as long as one of its descendants is new. Commits we already store from another branch
+// are only recorded as being on this one.
+//
+// The first sync of a branch has no previous head to stop at, so it backfills the whole history first, which
+// picks up from its checkpoint when interrupted instead of paging from the head again, see FetchAndSaveCommits.
+//
+// When the new head doesn't descend from the previous one, the branch was force-pushed or reset,
+// and the rewrite is recorded before the cursor moves on, see checkHistoryRewrite.
+func (g GitBeamService) SyncCommits(ctx context.Context, filters models.CommitFilters) (*models.SyncResult, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "SyncCommits")
+	name := filters.OwnerAndRepoName
+
+	branch, err := g.branchOrDefault(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := g.dataStore.GetSyncCursor(ctx, name, branch)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		useLogger.WithError(err).Error("failed to fetch sync cursor from the dataStore")
+		return nil, err
+	}
+
+	result := &models.SyncResult{}
+	if previous == nil {
+		// Paging from the head below then only lists what was pushed since, and finds the head to start from.
+		backfillFilters := filters
+		backfillFilters.Branch, backfillFilters.ToDate = branch, nil
+		if result.NewCommits, err = g.backfill(ctx, backfillFilters); err != nil {
+			return nil, err
+		}
+	}
+
+	options := source.ListCommitsOptions{
+		Branch:  branch,
+		Page:    1,
+		PerPage: 100,
+	}
+
+	if filters.FromDate != nil {
+		// History before the monitor's start date is never mirrored, so don't page past it.
+		options.Since = filters.FromDate.Time
+	}
+
+	pending := make(map[string]bool) // Parents of new commits we have not reached yet.
+
+paging:
+	for {
+		var commits []*models.Commit
+		response, err := g.callFetcher(ctx, name.Host, filters.Fetcher, 1, func(src source.CommitSource) (response *source.Response, err error) {
+			commits, response, err = src.ListCommits(ctx, name, options)
+			return response, err
+		})
+		if err != nil {
+			useLogger.WithError(err).Error("failed to list commits from source")
+			return nil, err
+		}
+
+		for _, commit := range commits {
+			sha := commit.SHA
+			if result.HeadSHA == "" {
+				result.HeadSHA = sha
+			}
+			delete(pending, sha)
+
+			if !g.isCommitOnBranch(ctx, name, sha, branch) {
+				isNew, err := g.saveCommitOnBranch(ctx, commit, branch, filters.Fetcher)
+				if err != nil {
+					useLogger.WithError(err).Errorln("error saving commit to storage.")
+					return nil, err
+				}
+				if isNew {
+					result.NewCommits++
+				}
+
+				for _, parent := range commit.ParentCommitIDs {
+					if !g.isCommitOnBranch(ctx, name, parent, branch) {
+						pending[parent] = true
+					}
+				}
+			}
+
+			if len(pending) == 0 {
+				break paging
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		options.Page = response.NextPage
+		options.Cursor = response.NextCursor
+	}
+
+	if previous != nil && result.HeadSHA != "" {
+		// Leave the cursor where it was on failure, so the next run checks again.
+		if result.HistoryRewrite, err = g.checkHistoryRewrite(ctx, name, branch, previous.HeadSHA, result.HeadSHA); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.HeadSHA != "" {
+		err := g.dataStore.SaveSyncCursor(ctx, &models.SyncCursor{
+			Host:         name.Host,
+			OwnerName:    name.OwnerName,
+			RepoName:     name.RepoName,
+			Branch:       branch,
+			HeadSHA:      result.HeadSHA,
+			NewCommits:   result.NewCommits,
+			LastSyncedAt: time.Now(),
+		})
+		if err != nil {
+			useLogger.WithError(err).Errorln("failed to save sync cursor")
+			return nil, err
+		}
+	}
+
+	useLogger.WithFields(logrus.Fields{
+		"ownerName":  name.OwnerName,
+		"repoName":   name.RepoName,
+		"branch":     branch,
+		"headSha":    result.HeadSHA,
+		"newCommits": result.NewCommits,
+	}).Info("synced repository commits")
+
+	return result, nil
+}
+
+// IngestPush mirrors a push to a branch reported by a webhook, whose new head is headSHA.
+//
+// Pushed commits are delivered without their parents or signatures, so the branch is synced from its new head
+// instead, which stops at the commits we already have. A redelivered push whose head we synced costs nothing.
+func (g GitBeamService) IngestPush(ctx context.Context, filters models.CommitFilters, headSHA string) (*models.SyncResult, error) {
+	if cursor, _ := g.dataStore.GetSyncCursor(ctx, filters.OwnerAndRepoName, filters.Branch); cursor != nil && cursor.HeadSHA == headSHA {
+		return &models.SyncResult{HeadSHA: headSHA}, nil
+	}
+
+	return g.SyncCommits(ctx, filters)
+}
+
+func (g GitBeamService) GetSyncStatus(ctx context.Context, owner models.OwnerAndRepoName) (*models.SyncStatus, error) {
+	useLogger := g.logger.WithContext(ctx).WithField("methodName", "GetSyncStatus")
+	status := &models.SyncStatus{Cursors: make([]*models.SyncCursor, 0)}
+
+	cursors, err := g.dataStore.ListSyncCursors(ctx, owner)
+	if err != nil {
+		useLogger.WithError(err).Errorln("failed to list sync cursors from the dataStore")
+	} else if cursors != nil {
+		status.Cursors = cursors
+	}
+
+	status.Backfills, _ = g.dataStore.ListBackfillCheckpoints(ctx, owner)
+	return status, nil
+}
+
+func (g GitBeamService) isCommitStored(ctx context.Context, owner models.OwnerAndRepoName, sha string) bool {
+	existing, _ := g.dataStore.GetCommitBySHA(ctx, owner, sha)
+	return existing != nil
+}
+
+func (g GitBeamService) isCommitOnBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) bool {
+	onBranch, _ := g.dataStore.IsCommitOnBranch(ctx, owner, sha, branch)
+	return onBranch
+}
+
+// enrichmentWindow is how recent a commit must be to have its details fetched as it is mirrored. Every detail takes
+// calls of its own, so older commits, which make up most of a backfill, only cost the calls listing them and have
+// their details fetched when first asked for.
+const enrichmentWindow = 7 * 24 * time.Hour
+
+// saveCommitOnBranch stores the commit, its checks, file changes and pull requests if we don't have them yet
+// and records that branch contains it. Commits older than enrichmentWindow are stored as listed.
+//
+// Commits listed by the GraphQL fetcher already carry their line stats and pull requests. Their files are only
+// fetched when first asked for, which spares REST calls per commit.
+func (g GitBeamService) saveCommitOnBranch(ctx context.Context, commit *models.Commit, branch, fetcher string) (isNew bool, err error) {
+	owner := models.OwnerAndRepoName{
+		Host:      commit.Host,
+		OwnerName: commit.OwnerName,
+		RepoName:  commit.RepoName,
+	}
+
+	if !g.isCommitStored(ctx, owner, commit.SHA) {
+		if err = g.dataStore.SaveCommit(ctx, commit); err != nil {
+			return false, err
+		}
+		isNew = true
+	}
+
+	recent := time.Since(commit.Date) < enrichmentWindow
+	if recent {
+		if err = g.ensureCommitChecks(ctx, owner, commit.SHA); err != nil {
+			return isNew, err
+		}
+	}
+
+	if fetcher == models.FetcherGraphQL {
+		if err = g.dataStore.SaveCommitPullRequests(ctx, owner, commit.SHA, commit.PullRequests); err != nil {
+			return isNew, err
+		}
+	} else if recent {
+		if err = g.ensureCommitDetails(ctx, owner, commit.SHA); err != nil {
+			return isNew, err
+		}
+
+		if err = g.ensureCommitPullRequests(ctx, owner, commit.SHA); err != nil {
+			return isNew, err
+		}
+	}
+
+	return isNew, g.dataStore.SaveCommitBranch(ctx, owner, commit.SHA, branch)
+}
@@ -0,0 +1,299 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gitbeam.baselib/store"
+	"gitbeam.commit.monitor/mocks"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/repository"
+	"gitbeam.commit.monitor/repository/sqlite"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSaveCommitOnBranchEnrichment(t *testing.T) {
+	tests := []struct {
+		name       string
+		age        time.Duration
+		wantChecks bool
+	}{
+		{"recent commit", time.Hour, true},
+		{"backfilled commit", enrichmentWindow + time.Hour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v3/repos/o/r/commits/s1/status":
+					fmt.Fprint(w, `{"state":"success","total_count":1,"statuses":[{"context":"ci","state":"success"}]}`)
+				case "/api/v3/repos/o/r/commits/s1/check-runs":
+					fmt.Fprint(w, `{"total_count":0,"check_runs":[]}`)
+				default:
+					t.Errorf("unexpected call to %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			dataStore := mocks.NewMockDataStore(ctrl)
+			service := newTestService(t, dataStore, models.SourceHost{
+				Provider: models.ProviderGithub,
+				Name:     "ghe",
+				BaseURL:  server.URL + "/api/v3/",
+			})
+
+			commit := &models.Commit{Date: time.Now().Add(-tt.age), Host: "ghe", OwnerName: "o", RepoName: "r", SHA: "s1"}
+			owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+
+			dataStore.EXPECT().GetCommitBySHA(gomock.Any(), owner, "s1").Return(nil, sql.ErrNoRows)
+			dataStore.EXPECT().SaveCommit(gomock.Any(), commit).Return(nil)
+			dataStore.EXPECT().SaveCommitBranch(gomock.Any(), owner, "s1", "main").Return(nil)
+			if tt.wantChecks {
+				dataStore.EXPECT().GetCommitChecks(gomock.Any(), owner, "s1").Return(nil, sql.ErrNoRows)
+				dataStore.EXPECT().SaveCommitChecks(gomock.Any(), gomock.Any()).Return(nil)
+				// Details and pull requests of the commit were fetched before, e.g. on another branch.
+				dataStore.EXPECT().HasCommitDetails(gomock.Any(), "s1").Return(true, nil)
+				dataStore.EXPECT().HasCommitPullRequests(gomock.Any(), owner, "s1").Return(true, nil)
+			}
+
+			isNew, err := service.saveCommitOnBranch(context.Background(), commit, "main", models.FetcherREST)
+			if err != nil || !isNew {
+				t.Fatalf("saveCommitOnBranch() = %v, %v, want a new commit", isNew, err)
+			}
+
+			if wantRequests := map[bool]int{true: 2, false: 0}[tt.wantChecks]; requests != wantRequests {
+				t.Errorf("made %d requests, want %d", requests, wantRequests)
+			}
+		})
+	}
+}
+
+func TestRateBudgetReservesEveryCall(t *testing.T) {
+	token := &sourceToken{name: "t", remaining: 10, cost: 1}
+	budget := newRateBudget(nil, []*sourceToken{token})
+
+	if _, err := budget.acquire(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	if token.remaining != 8 {
+		t.Errorf("remaining = %d after reserving 2 calls, want 8", token.remaining)
+	}
+}
+
+// githubBranch stands in for the commits API of a GitHub host, listing the commits of main two to a page,
+// newest first by date like GitHub does.
+type githubBranch struct {
+	commits  []string // SHA and comma separated parents of every commit, e.g. "d:c,f", newest first.
+	pages    []int    // Pages listed so far.
+	failPage int      // Page listing fails once, 0 for none.
+}
+
+func (g *githubBranch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/api/v3/repos/o/r/commits":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		g.pages = append(g.pages, page)
+		if page == g.failPage {
+			g.failPage = 0
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"failed"}`)
+			return
+		}
+
+		end := min(2*page, len(g.commits))
+		if end < len(g.commits) {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/v3/repos/o/r/commits?page=%d>; rel="next"`, r.Host, page+1))
+		}
+
+		listed := make([]string, 0, 2)
+		for i, commit := range g.commits[2*(page-1) : end] {
+			sha, parents, _ := strings.Cut(commit, ":")
+			parentsJSON := make([]string, 0)
+			for _, parent := range strings.Split(parents, ",") {
+				if parent != "" {
+					parentsJSON = append(parentsJSON, fmt.Sprintf(`{"sha":%q}`, parent))
+				}
+			}
+
+			// Backfilled history, so the commits are stored without their details.
+			date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(len(g.commits)-2*(page-1)-i) * time.Hour)
+			listed = append(listed, fmt.Sprintf(`{"sha":%q,"parents":[%s],"commit":{"message":"m","committer":{"date":%q}}}`,
+				sha, strings.Join(parentsJSON, ","), date.Format(time.RFC3339)))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(listed, ","))
+	case "/api/v3/repos/o/r/compare/c...d":
+		fmt.Fprint(w, `{"status":"ahead"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	}
+}
+
+// branchDatabases numbers the in-memory databases opened by newBranchService.
+var branchDatabases atomic.Int64
+
+// newBranchService returns a service mirroring o/r on a GitHub host named ghe, served by branch, into a sqlite store.
+func newBranchService(t *testing.T, branch *githubBranch) (*GitBeamService, repository.DataStore) {
+	t.Helper()
+	server := httptest.NewServer(branch)
+	t.Cleanup(server.Close)
+
+	// The store can't be closed from here, so every run gets a database of its own rather than the rows of the last.
+	dataStore, err := sqlite.NewSqliteRepo(fmt.Sprintf("file:%s_%d?mode=memory&cache=shared", t.Name(), branchDatabases.Add(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	service, err := NewGitBeamService(logger, store.NewEventStore(logger), dataStore, nil, []models.SourceHost{
+		{Provider: models.ProviderGithub, Name: "ghe", BaseURL: server.URL + "/api/v3/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service, dataStore
+}
+
+func TestSyncCommitsStopsAtMirroredHistory(t *testing.T) {
+	branch := &githubBranch{commits: []string{"c:b", "b:a", "a:"}}
+	service, dataStore := newBranchService(t, branch)
+
+	ctx := context.Background()
+	filters := models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+	}
+
+	// The first sync backfills the whole history, then finds the head from page 1.
+	result, err := service.SyncCommits(ctx, filters)
+	if err != nil || result.HeadSHA != "c" || result.NewCommits != 3 {
+		t.Fatalf("SyncCommits() = %+v, %v, want 3 new commits up to c", result, err)
+	}
+	if pages := fmt.Sprint(branch.pages); pages != "[1 2 1]" {
+		t.Errorf("listed pages %s, want [1 2 1]", pages)
+	}
+
+	tests := []struct {
+		name           string
+		commits        []string
+		wantHead       string
+		wantNewCommits int
+		wantPages      string
+	}{
+		{
+			name:      "nothing pushed",
+			commits:   []string{"c:b", "b:a", "a:"},
+			wantHead:  "c",
+			wantPages: "[1]",
+		},
+		{
+			// f is dated before c, so it's listed after the history d was merged into. The sync still pages on
+			// until it reaches f, whose parent is mirrored, and stops without listing the rest.
+			name:           "late merge",
+			commits:        []string{"d:c,f", "c:b", "b:a", "f:a", "a:", "z:"},
+			wantHead:       "d",
+			wantNewCommits: 2,
+			wantPages:      "[1 2]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			branch.commits, branch.pages = tt.commits, nil
+
+			result, err := service.SyncCommits(ctx, filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.HeadSHA != tt.wantHead || result.NewCommits != tt.wantNewCommits || result.HistoryRewrite != nil {
+				t.Errorf("SyncCommits() = %+v, want %d new commits up to %s", result, tt.wantNewCommits, tt.wantHead)
+			}
+			if pages := fmt.Sprint(branch.pages); pages != tt.wantPages {
+				t.Errorf("listed pages %s, want %s", pages, tt.wantPages)
+			}
+
+			for _, commit := range tt.commits[:tt.wantNewCommits] {
+				sha, _, _ := strings.Cut(commit, ":")
+				if onBranch, _ := dataStore.IsCommitOnBranch(ctx, filters.OwnerAndRepoName, sha, "main"); !onBranch {
+					t.Errorf("%s isn't recorded on main", sha)
+				}
+			}
+		})
+	}
+
+	// f was reached through d, though listed after commits mirrored before.
+	if onBranch, _ := dataStore.IsCommitOnBranch(ctx, filters.OwnerAndRepoName, "f", "main"); !onBranch {
+		t.Error("f isn't recorded on main")
+	}
+}
+
+func TestSyncCommitsResumesFirstSync(t *testing.T) {
+	branch := &githubBranch{commits: []string{"c:b", "b:a", "a:"}, failPage: 2}
+	service, dataStore := newBranchService(t, branch)
+
+	ctx := context.Background()
+	filters := models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+	}
+
+	if _, err := service.SyncCommits(ctx, filters); err == nil {
+		t.Fatal("SyncCommits() error = nil, want listing page 2 to fail")
+	}
+	if cursor, err := dataStore.GetSyncCursor(ctx, filters.OwnerAndRepoName, "main"); err == nil {
+		t.Fatalf("GetSyncCursor() = %+v, want no cursor before the history is mirrored", cursor)
+	}
+
+	// The next sync picks up the backfill at page 2 instead of paging from the head again.
+	branch.pages = nil
+	result, err := service.SyncCommits(ctx, filters)
+	if err != nil || result.HeadSHA != "c" || result.NewCommits != 1 {
+		t.Fatalf("SyncCommits() = %+v, %v, want a mirrored up to c", result, err)
+	}
+	if pages := fmt.Sprint(branch.pages); pages != "[2 1]" {
+		t.Errorf("listed pages %s, want [2 1]", pages)
+	}
+}
+
+func TestSyncCommitsCursorReadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected call to %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	service := newTestService(t, dataStore, models.SourceHost{Provider: models.ProviderGithub, Name: "ghe", BaseURL: server.URL + "/api/v3/"})
+
+	// A cursor we failed to read may well exist, backfilling the branch again would spend the budget on it.
+	readErr := errors.New("disk I/O error")
+	dataStore.EXPECT().GetSyncCursor(gomock.Any(), gomock.Any(), "main").Return(nil, readErr)
+
+	_, err := service.SyncCommits(context.Background(), models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+	})
+	if !errors.Is(err, readErr) {
+		t.Errorf("SyncCommits() error = %v, want %v", err, readErr)
+	}
+}
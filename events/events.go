@@ -54,12 +54,10 @@ func (e EventHandlers) handleCronTaskCreated() error {
 		ctx := context.Background()
 
 		params := models.CommitFilters{
-			OwnerAndRepoName: models.OwnerAndRepoName{
-				OwnerName: config.OwnerName,
-				RepoName:  config.RepoName,
-			},
-			FromDate: nil,
-			ToDate:   nil,
+			OwnerAndRepoName: config.OwnerAndRepoName(),
+			FromDate:         nil,
+			ToDate:           nil,
+			Fetcher:          config.Fetcher,
 		}
 
 		if config.FromDate != "" {
@@ -70,6 +68,27 @@ func (e EventHandlers) handleCronTaskCreated() error {
 			params.ToDate, _ = models.ParseDate(config.ToDate) // Defaults to null if nothing.
 		}
 
-		return e.service.FetchAndSaveCommits(ctx, params)
+		repo, err := e.service.RefreshRepository(ctx, params.OwnerAndRepoName, config.SourceID)
+		if err != nil {
+			return err
+		}
+
+		// A renamed repository is mirrored under its new name, its monitor and what we stored of it under the old
+		// one follow on its first scheduled run.
+		params.OwnerAndRepoName = repo.OwnerAndRepoName()
+
+		branches, err := e.service.ResolveBranches(ctx, params.OwnerAndRepoName, config.Branches)
+		if err != nil {
+			return err
+		}
+
+		for _, branch := range branches {
+			params.Branch = branch
+			if err := e.service.FetchAndSaveCommits(ctx, params); err != nil {
+				return err
+			}
+		}
+
+		return e.service.SyncReleases(ctx, params.OwnerAndRepoName)
 	})
 }
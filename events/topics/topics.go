@@ -3,10 +3,13 @@ package topics
 const (
 	RepoCreated   = "com.gitbeam.repos.repo.created"
 	RepoDeleted   = "com.gitbeam.repos.repo.deleted"
+	RepoRenamed   = "com.gitbeam.repos.repo.renamed"
 	CommitCreated = "com.gitbeam.commits.commit.created"
 )
 
 const (
 	MonitorTaskCreated = "gitbeam.commit.monitor.task.created"
 	MonitorTaskDeleted = "gitbeam.commit.monitor.task.deleted"
+	HistoryRewritten   = "gitbeam.commit.monitor.history.rewritten"
+	SourceHostHealth   = "gitbeam.commit.monitor.source.health.changed"
 )
@@ -39,7 +39,10 @@ func main() {
 
 	// If the dependencies were more than 3, I would use a variadic function to inject them.
 	//Clarity is better here for this exercise.
-	coreService := core.NewGitBeamService(logger, eventStore, dataStore, nil)
+	coreService, err := core.NewGitBeamService(logger, eventStore, dataStore, nil, secrets.SourceHosts)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to initialize source host clients.")
+	}
 
 	// To handle event-based background activities. ( in a real world system, this would be apache-pulsar, kafka, nats.io or rabbitmq )
 	go events.NewEventHandler(eventStore, logger, coreService).Listen()
@@ -56,6 +59,12 @@ func main() {
 	address := fmt.Sprintf("0.0.0.0:%s", secrets.Port)
 	logger.Printf("[*] %s listening on address: %s", config.ServiceName, address)
 
+	if secrets.WebhookPort != "" {
+		webhookAddress := fmt.Sprintf("0.0.0.0:%s", secrets.WebhookPort)
+		logger.Printf("[*] %s receiving webhooks on address: %s", config.ServiceName, webhookAddress)
+		go server.ExecHTTPServer(webhookAddress, server.NewWebhookHandler(coreService, schedulerService, logger))
+	}
+
 	api := server.NewApiService(coreService, schedulerService, logger)
 	server.ExecGRPCServer(address, api)
 }
@@ -36,6 +36,35 @@ func (m *MockDataStore) EXPECT() *MockDataStoreMockRecorder {
 	return m.recorder
 }
 
+// DeleteAuthorAlias mocks base method.
+func (m *MockDataStore) DeleteAuthorAlias(ctx context.Context, aliasName, aliasEmail string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthorAlias", ctx, aliasName, aliasEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthorAlias indicates an expected call of DeleteAuthorAlias.
+func (mr *MockDataStoreMockRecorder) DeleteAuthorAlias(ctx, aliasName, aliasEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthorAlias", reflect.TypeOf((*MockDataStore)(nil).DeleteAuthorAlias), ctx, aliasName, aliasEmail)
+}
+
+// GetBackfillCheckpoint mocks base method.
+func (m *MockDataStore) GetBackfillCheckpoint(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackfillCheckpoint", ctx, owner, branch)
+	ret0, _ := ret[0].(*models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackfillCheckpoint indicates an expected call of GetBackfillCheckpoint.
+func (mr *MockDataStoreMockRecorder) GetBackfillCheckpoint(ctx, owner, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackfillCheckpoint", reflect.TypeOf((*MockDataStore)(nil).GetBackfillCheckpoint), ctx, owner, branch)
+}
+
 // GetCommitBySHA mocks base method.
 func (m *MockDataStore) GetCommitBySHA(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.Commit, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +80,21 @@ func (mr *MockDataStoreMockRecorder) GetCommitBySHA(ctx, owner, sha interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitBySHA", reflect.TypeOf((*MockDataStore)(nil).GetCommitBySHA), ctx, owner, sha)
 }
 
+// GetCommitChecks mocks base method.
+func (m *MockDataStore) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitChecks", ctx, owner, sha)
+	ret0, _ := ret[0].(*models.CommitChecks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitChecks indicates an expected call of GetCommitChecks.
+func (mr *MockDataStoreMockRecorder) GetCommitChecks(ctx, owner, sha interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitChecks", reflect.TypeOf((*MockDataStore)(nil).GetCommitChecks), ctx, owner, sha)
+}
+
 // GetLastCommit mocks base method.
 func (m *MockDataStore) GetLastCommit(ctx context.Context, owner *models.OwnerAndRepoName, startTime *time.Time) (*models.Commit, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +110,66 @@ func (mr *MockDataStoreMockRecorder) GetLastCommit(ctx, owner, startTime interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastCommit", reflect.TypeOf((*MockDataStore)(nil).GetLastCommit), ctx, owner, startTime)
 }
 
+// GetRepository mocks base method.
+func (m *MockDataStore) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepository", ctx, owner)
+	ret0, _ := ret[0].(*models.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepository indicates an expected call of GetRepository.
+func (mr *MockDataStoreMockRecorder) GetRepository(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockDataStore)(nil).GetRepository), ctx, owner)
+}
+
+// GetSignatureReport mocks base method.
+func (m *MockDataStore) GetSignatureReport(ctx context.Context, filter models.CommitFilters) (*models.SignatureReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignatureReport", ctx, filter)
+	ret0, _ := ret[0].(*models.SignatureReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignatureReport indicates an expected call of GetSignatureReport.
+func (mr *MockDataStoreMockRecorder) GetSignatureReport(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureReport", reflect.TypeOf((*MockDataStore)(nil).GetSignatureReport), ctx, filter)
+}
+
+// GetSyncCursor mocks base method.
+func (m *MockDataStore) GetSyncCursor(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.SyncCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncCursor", ctx, owner, branch)
+	ret0, _ := ret[0].(*models.SyncCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncCursor indicates an expected call of GetSyncCursor.
+func (mr *MockDataStoreMockRecorder) GetSyncCursor(ctx, owner, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncCursor", reflect.TypeOf((*MockDataStore)(nil).GetSyncCursor), ctx, owner, branch)
+}
+
+// GetTag mocks base method.
+func (m *MockDataStore) GetTag(ctx context.Context, owner models.OwnerAndRepoName, name string) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", ctx, owner, name)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockDataStoreMockRecorder) GetTag(ctx, owner, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockDataStore)(nil).GetTag), ctx, owner, name)
+}
+
 // GetTopCommitAuthors mocks base method.
 func (m *MockDataStore) GetTopCommitAuthors(ctx context.Context, filter models.CommitFilters) ([]*models.TopCommitAuthor, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +185,96 @@ func (mr *MockDataStoreMockRecorder) GetTopCommitAuthors(ctx, filter interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopCommitAuthors", reflect.TypeOf((*MockDataStore)(nil).GetTopCommitAuthors), ctx, filter)
 }
 
+// HasCommitDetails mocks base method.
+func (m *MockDataStore) HasCommitDetails(ctx context.Context, sha string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasCommitDetails", ctx, sha)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasCommitDetails indicates an expected call of HasCommitDetails.
+func (mr *MockDataStoreMockRecorder) HasCommitDetails(ctx, sha interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCommitDetails", reflect.TypeOf((*MockDataStore)(nil).HasCommitDetails), ctx, sha)
+}
+
+// HasCommitPullRequests mocks base method.
+func (m *MockDataStore) HasCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasCommitPullRequests", ctx, owner, sha)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasCommitPullRequests indicates an expected call of HasCommitPullRequests.
+func (mr *MockDataStoreMockRecorder) HasCommitPullRequests(ctx, owner, sha interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCommitPullRequests", reflect.TypeOf((*MockDataStore)(nil).HasCommitPullRequests), ctx, owner, sha)
+}
+
+// IsCommitOnBranch mocks base method.
+func (m *MockDataStore) IsCommitOnBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCommitOnBranch", ctx, owner, sha, branch)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCommitOnBranch indicates an expected call of IsCommitOnBranch.
+func (mr *MockDataStoreMockRecorder) IsCommitOnBranch(ctx, owner, sha, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCommitOnBranch", reflect.TypeOf((*MockDataStore)(nil).IsCommitOnBranch), ctx, owner, sha, branch)
+}
+
+// ListAuthorAliases mocks base method.
+func (m *MockDataStore) ListAuthorAliases(ctx context.Context) ([]*models.AuthorAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthorAliases", ctx)
+	ret0, _ := ret[0].([]*models.AuthorAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthorAliases indicates an expected call of ListAuthorAliases.
+func (mr *MockDataStoreMockRecorder) ListAuthorAliases(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorAliases", reflect.TypeOf((*MockDataStore)(nil).ListAuthorAliases), ctx)
+}
+
+// ListBackfillCheckpoints mocks base method.
+func (m *MockDataStore) ListBackfillCheckpoints(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackfillCheckpoints", ctx, owner)
+	ret0, _ := ret[0].([]*models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackfillCheckpoints indicates an expected call of ListBackfillCheckpoints.
+func (mr *MockDataStoreMockRecorder) ListBackfillCheckpoints(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackfillCheckpoints", reflect.TypeOf((*MockDataStore)(nil).ListBackfillCheckpoints), ctx, owner)
+}
+
+// ListCommitFiles mocks base method.
+func (m *MockDataStore) ListCommitFiles(ctx context.Context, sha string) ([]*models.CommitFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitFiles", ctx, sha)
+	ret0, _ := ret[0].([]*models.CommitFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitFiles indicates an expected call of ListCommitFiles.
+func (mr *MockDataStoreMockRecorder) ListCommitFiles(ctx, sha interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitFiles", reflect.TypeOf((*MockDataStore)(nil).ListCommitFiles), ctx, sha)
+}
+
 // ListCommits mocks base method.
 func (m *MockDataStore) ListCommits(ctx context.Context, filter models.CommitFilters) ([]*models.Commit, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +290,153 @@ func (mr *MockDataStoreMockRecorder) ListCommits(ctx, filter interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockDataStore)(nil).ListCommits), ctx, filter)
 }
 
+// ListCommitsBetween mocks base method.
+func (m *MockDataStore) ListCommitsBetween(ctx context.Context, owner models.OwnerAndRepoName, fromSHA, toSHA string) ([]*models.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitsBetween", ctx, owner, fromSHA, toSHA)
+	ret0, _ := ret[0].([]*models.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitsBetween indicates an expected call of ListCommitsBetween.
+func (mr *MockDataStoreMockRecorder) ListCommitsBetween(ctx, owner, fromSHA, toSHA interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitsBetween", reflect.TypeOf((*MockDataStore)(nil).ListCommitsBetween), ctx, owner, fromSHA, toSHA)
+}
+
+// ListHistoryRewrites mocks base method.
+func (m *MockDataStore) ListHistoryRewrites(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.HistoryRewrite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistoryRewrites", ctx, owner)
+	ret0, _ := ret[0].([]*models.HistoryRewrite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryRewrites indicates an expected call of ListHistoryRewrites.
+func (mr *MockDataStoreMockRecorder) ListHistoryRewrites(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryRewrites", reflect.TypeOf((*MockDataStore)(nil).ListHistoryRewrites), ctx, owner)
+}
+
+// ListPendingCommitChecks mocks base method.
+func (m *MockDataStore) ListPendingCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, since time.Time, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingCommitChecks", ctx, owner, since, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingCommitChecks indicates an expected call of ListPendingCommitChecks.
+func (mr *MockDataStoreMockRecorder) ListPendingCommitChecks(ctx, owner, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingCommitChecks", reflect.TypeOf((*MockDataStore)(nil).ListPendingCommitChecks), ctx, owner, since, limit)
+}
+
+// ListPullRequestCommits mocks base method.
+func (m *MockDataStore) ListPullRequestCommits(ctx context.Context, owner models.OwnerAndRepoName, number int) ([]*models.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequestCommits", ctx, owner, number)
+	ret0, _ := ret[0].([]*models.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequestCommits indicates an expected call of ListPullRequestCommits.
+func (mr *MockDataStoreMockRecorder) ListPullRequestCommits(ctx, owner, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestCommits", reflect.TypeOf((*MockDataStore)(nil).ListPullRequestCommits), ctx, owner, number)
+}
+
+// ListReleases mocks base method.
+func (m *MockDataStore) ListReleases(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReleases", ctx, owner)
+	ret0, _ := ret[0].([]*models.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReleases indicates an expected call of ListReleases.
+func (mr *MockDataStoreMockRecorder) ListReleases(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockDataStore)(nil).ListReleases), ctx, owner)
+}
+
+// ListRepositories mocks base method.
+func (m *MockDataStore) ListRepositories(ctx context.Context) ([]*models.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", ctx)
+	ret0, _ := ret[0].([]*models.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockDataStoreMockRecorder) ListRepositories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockDataStore)(nil).ListRepositories), ctx)
+}
+
+// ListSyncCursors mocks base method.
+func (m *MockDataStore) ListSyncCursors(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.SyncCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSyncCursors", ctx, owner)
+	ret0, _ := ret[0].([]*models.SyncCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSyncCursors indicates an expected call of ListSyncCursors.
+func (mr *MockDataStoreMockRecorder) ListSyncCursors(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSyncCursors", reflect.TypeOf((*MockDataStore)(nil).ListSyncCursors), ctx, owner)
+}
+
+// RenameRepository mocks base method.
+func (m *MockDataStore) RenameRepository(ctx context.Context, from, to models.OwnerAndRepoName) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameRepository", ctx, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameRepository indicates an expected call of RenameRepository.
+func (mr *MockDataStoreMockRecorder) RenameRepository(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRepository", reflect.TypeOf((*MockDataStore)(nil).RenameRepository), ctx, from, to)
+}
+
+// SaveAuthorAliases mocks base method.
+func (m *MockDataStore) SaveAuthorAliases(ctx context.Context, aliases []*models.AuthorAlias) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuthorAliases", ctx, aliases)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuthorAliases indicates an expected call of SaveAuthorAliases.
+func (mr *MockDataStoreMockRecorder) SaveAuthorAliases(ctx, aliases interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuthorAliases", reflect.TypeOf((*MockDataStore)(nil).SaveAuthorAliases), ctx, aliases)
+}
+
+// SaveBackfillCheckpoint mocks base method.
+func (m *MockDataStore) SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBackfillCheckpoint", ctx, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBackfillCheckpoint indicates an expected call of SaveBackfillCheckpoint.
+func (mr *MockDataStoreMockRecorder) SaveBackfillCheckpoint(ctx, checkpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBackfillCheckpoint", reflect.TypeOf((*MockDataStore)(nil).SaveBackfillCheckpoint), ctx, checkpoint)
+}
+
 // SaveCommit mocks base method.
 func (m *MockDataStore) SaveCommit(ctx context.Context, payload *models.Commit) error {
 	m.ctrl.T.Helper()
@@ -110,6 +451,132 @@ func (mr *MockDataStoreMockRecorder) SaveCommit(ctx, payload interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommit", reflect.TypeOf((*MockDataStore)(nil).SaveCommit), ctx, payload)
 }
 
+// SaveCommitBranch mocks base method.
+func (m *MockDataStore) SaveCommitBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommitBranch", ctx, owner, sha, branch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCommitBranch indicates an expected call of SaveCommitBranch.
+func (mr *MockDataStoreMockRecorder) SaveCommitBranch(ctx, owner, sha, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommitBranch", reflect.TypeOf((*MockDataStore)(nil).SaveCommitBranch), ctx, owner, sha, branch)
+}
+
+// SaveCommitChecks mocks base method.
+func (m *MockDataStore) SaveCommitChecks(ctx context.Context, checks *models.CommitChecks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommitChecks", ctx, checks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCommitChecks indicates an expected call of SaveCommitChecks.
+func (mr *MockDataStoreMockRecorder) SaveCommitChecks(ctx, checks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommitChecks", reflect.TypeOf((*MockDataStore)(nil).SaveCommitChecks), ctx, checks)
+}
+
+// SaveCommitDetails mocks base method.
+func (m *MockDataStore) SaveCommitDetails(ctx context.Context, sha string, additions, deletions int, files []*models.CommitFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommitDetails", ctx, sha, additions, deletions, files)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCommitDetails indicates an expected call of SaveCommitDetails.
+func (mr *MockDataStoreMockRecorder) SaveCommitDetails(ctx, sha, additions, deletions, files interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommitDetails", reflect.TypeOf((*MockDataStore)(nil).SaveCommitDetails), ctx, sha, additions, deletions, files)
+}
+
+// SaveCommitPullRequests mocks base method.
+func (m *MockDataStore) SaveCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string, pullRequests []*models.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommitPullRequests", ctx, owner, sha, pullRequests)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCommitPullRequests indicates an expected call of SaveCommitPullRequests.
+func (mr *MockDataStoreMockRecorder) SaveCommitPullRequests(ctx, owner, sha, pullRequests interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommitPullRequests", reflect.TypeOf((*MockDataStore)(nil).SaveCommitPullRequests), ctx, owner, sha, pullRequests)
+}
+
+// SaveHistoryRewrite mocks base method.
+func (m *MockDataStore) SaveHistoryRewrite(ctx context.Context, rewrite *models.HistoryRewrite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveHistoryRewrite", ctx, rewrite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveHistoryRewrite indicates an expected call of SaveHistoryRewrite.
+func (mr *MockDataStoreMockRecorder) SaveHistoryRewrite(ctx, rewrite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveHistoryRewrite", reflect.TypeOf((*MockDataStore)(nil).SaveHistoryRewrite), ctx, rewrite)
+}
+
+// SaveReleases mocks base method.
+func (m *MockDataStore) SaveReleases(ctx context.Context, owner models.OwnerAndRepoName, releases []*models.Release) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReleases", ctx, owner, releases)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReleases indicates an expected call of SaveReleases.
+func (mr *MockDataStoreMockRecorder) SaveReleases(ctx, owner, releases interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReleases", reflect.TypeOf((*MockDataStore)(nil).SaveReleases), ctx, owner, releases)
+}
+
+// SaveRepository mocks base method.
+func (m *MockDataStore) SaveRepository(ctx context.Context, repo *models.Repository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRepository", ctx, repo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRepository indicates an expected call of SaveRepository.
+func (mr *MockDataStoreMockRecorder) SaveRepository(ctx, repo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRepository", reflect.TypeOf((*MockDataStore)(nil).SaveRepository), ctx, repo)
+}
+
+// SaveSyncCursor mocks base method.
+func (m *MockDataStore) SaveSyncCursor(ctx context.Context, cursor *models.SyncCursor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSyncCursor", ctx, cursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSyncCursor indicates an expected call of SaveSyncCursor.
+func (mr *MockDataStoreMockRecorder) SaveSyncCursor(ctx, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSyncCursor", reflect.TypeOf((*MockDataStore)(nil).SaveSyncCursor), ctx, cursor)
+}
+
+// SaveTags mocks base method.
+func (m *MockDataStore) SaveTags(ctx context.Context, owner models.OwnerAndRepoName, tags []*models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTags", ctx, owner, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTags indicates an expected call of SaveTags.
+func (mr *MockDataStoreMockRecorder) SaveTags(ctx, owner, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTags", reflect.TypeOf((*MockDataStore)(nil).SaveTags), ctx, owner, tags)
+}
+
 // MockCronServiceStore is a mock of CronServiceStore interface.
 type MockCronServiceStore struct {
 	ctrl     *gomock.Controller
@@ -162,6 +629,21 @@ func (mr *MockCronServiceStoreMockRecorder) GetMonitorConfig(ctx, owner interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitorConfig", reflect.TypeOf((*MockCronServiceStore)(nil).GetMonitorConfig), ctx, owner)
 }
 
+// GetMonitorConfigBySourceID mocks base method.
+func (m *MockCronServiceStore) GetMonitorConfigBySourceID(ctx context.Context, host string, sourceID int64) (*models.MonitorRepositoryCommitConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitorConfigBySourceID", ctx, host, sourceID)
+	ret0, _ := ret[0].(*models.MonitorRepositoryCommitConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitorConfigBySourceID indicates an expected call of GetMonitorConfigBySourceID.
+func (mr *MockCronServiceStoreMockRecorder) GetMonitorConfigBySourceID(ctx, host, sourceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitorConfigBySourceID", reflect.TypeOf((*MockCronServiceStore)(nil).GetMonitorConfigBySourceID), ctx, host, sourceID)
+}
+
 // ListMonitorConfig mocks base method.
 func (m *MockCronServiceStore) ListMonitorConfig(ctx context.Context) ([]*models.MonitorRepositoryCommitConfig, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +659,20 @@ func (mr *MockCronServiceStoreMockRecorder) ListMonitorConfig(ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitorConfig", reflect.TypeOf((*MockCronServiceStore)(nil).ListMonitorConfig), ctx)
 }
 
+// RenameMonitorConfig mocks base method.
+func (m *MockCronServiceStore) RenameMonitorConfig(ctx context.Context, from models.OwnerAndRepoName, config *models.MonitorRepositoryCommitConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameMonitorConfig", ctx, from, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameMonitorConfig indicates an expected call of RenameMonitorConfig.
+func (mr *MockCronServiceStoreMockRecorder) RenameMonitorConfig(ctx, from, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameMonitorConfig", reflect.TypeOf((*MockCronServiceStore)(nil).RenameMonitorConfig), ctx, from, config)
+}
+
 // SaveMonitorConfigs mocks base method.
 func (m *MockCronServiceStore) SaveMonitorConfigs(ctx context.Context, task models.MonitorRepositoryCommitConfig) error {
 	m.ctrl.T.Helper()
@@ -190,3 +686,17 @@ func (mr *MockCronServiceStoreMockRecorder) SaveMonitorConfigs(ctx, task interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMonitorConfigs", reflect.TypeOf((*MockCronServiceStore)(nil).SaveMonitorConfigs), ctx, task)
 }
+
+// SaveMonitorStatus mocks base method.
+func (m *MockCronServiceStore) SaveMonitorStatus(ctx context.Context, config *models.MonitorRepositoryCommitConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMonitorStatus", ctx, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMonitorStatus indicates an expected call of SaveMonitorStatus.
+func (mr *MockCronServiceStoreMockRecorder) SaveMonitorStatus(ctx, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMonitorStatus", reflect.TypeOf((*MockCronServiceStore)(nil).SaveMonitorStatus), ctx, config)
+}
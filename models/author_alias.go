@@ -0,0 +1,108 @@
+package models
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"io"
+	"strings"
+)
+
+// AuthorAlias maps an identity found on commits to the canonical identity it is reported as.
+//
+// An alias matches commits by AliasEmail, AliasName or both; an empty alias field matches any value.
+// An empty canonical field leaves that part of the identity as recorded on the commit, like .mailmap does.
+type AuthorAlias struct {
+	CanonicalName  string `json:"canonicalName"`
+	CanonicalEmail string `json:"canonicalEmail"`
+	AliasName      string `json:"aliasName"`
+	AliasEmail     string `json:"aliasEmail"`
+}
+
+func (a AuthorAlias) Validate() error {
+	return validation.ValidateStruct(&a,
+		validation.Field(&a.AliasName, validation.By(requiredWithout(a.AliasEmail, "aliasEmail"))),
+		validation.Field(&a.CanonicalName, validation.By(requiredWithout(a.CanonicalEmail, "canonicalEmail"))),
+	)
+}
+
+// requiredWithout requires a value unless the other field is set.
+func requiredWithout(other, otherName string) validation.RuleFunc {
+	return func(value interface{}) error {
+		if s, _ := value.(string); s == "" && other == "" {
+			return fmt.Errorf("either this or %s is required", otherName)
+		}
+		return nil
+	}
+}
+
+// ParseMailmap reads aliases in the git .mailmap format, where each line is one of:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+func ParseMailmap(reader io.Reader) ([]*AuthorAlias, error) {
+	aliases := make([]*AuthorAlias, 0)
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		alias, err := parseMailmapLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("mailmap line %d: %w", lineNumber, err)
+		}
+
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, scanner.Err()
+}
+
+func parseMailmapLine(line string) (*AuthorAlias, error) {
+	var names, emails []string
+	for {
+		start := strings.Index(line, "<")
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(line[start:], ">")
+		if end < 0 {
+			return nil, errors.New("unterminated email")
+		}
+
+		names = append(names, strings.TrimSpace(line[:start]))
+		emails = append(emails, strings.TrimSpace(line[start+1:start+end]))
+		line = line[start+end+1:]
+	}
+
+	if len(emails) == 0 || strings.TrimSpace(line) != "" {
+		return nil, errors.New("expected names followed by <email>")
+	}
+
+	alias := &AuthorAlias{CanonicalName: names[0]}
+	switch len(emails) {
+	case 1:
+		if alias.CanonicalName == "" {
+			return nil, errors.New("a single email needs a proper name")
+		}
+		alias.AliasEmail = emails[0]
+	case 2:
+		alias.CanonicalEmail = emails[0]
+		alias.AliasName = names[1]
+		alias.AliasEmail = emails[1]
+	default:
+		return nil, errors.New("expected one or two emails")
+	}
+
+	return alias, nil
+}
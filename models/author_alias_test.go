@@ -0,0 +1,79 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMailmap(t *testing.T) {
+	tests := []struct {
+		name    string
+		mailmap string
+		want    []*AuthorAlias
+		wantErr bool
+	}{
+		{
+			name:    "proper name",
+			mailmap: "Jane Doe <jane@example.com>",
+			want:    []*AuthorAlias{{CanonicalName: "Jane Doe", AliasEmail: "jane@example.com"}},
+		},
+		{
+			name:    "proper email",
+			mailmap: "<jane@example.com> <jd@old.example.com>",
+			want:    []*AuthorAlias{{CanonicalEmail: "jane@example.com", AliasEmail: "jd@old.example.com"}},
+		},
+		{
+			name:    "proper name and email",
+			mailmap: "Jane Doe <jane@example.com> <jd@old.example.com>",
+			want:    []*AuthorAlias{{CanonicalName: "Jane Doe", CanonicalEmail: "jane@example.com", AliasEmail: "jd@old.example.com"}},
+		},
+		{
+			name:    "commit name and email",
+			mailmap: "Jane Doe <jane@example.com> jd <jd@old.example.com>",
+			want: []*AuthorAlias{{
+				CanonicalName:  "Jane Doe",
+				CanonicalEmail: "jane@example.com",
+				AliasName:      "jd",
+				AliasEmail:     "jd@old.example.com",
+			}},
+		},
+		{
+			name:    "comments and blank lines",
+			mailmap: "# Team\n\nJane Doe <jane@example.com> # moved\n   \n<joe@example.com> <joe@old.example.com>\n",
+			want: []*AuthorAlias{
+				{CanonicalName: "Jane Doe", AliasEmail: "jane@example.com"},
+				{CanonicalEmail: "joe@example.com", AliasEmail: "joe@old.example.com"},
+			},
+		},
+		{
+			name:    "empty",
+			mailmap: "",
+			want:    []*AuthorAlias{},
+		},
+		{name: "single email without a name", mailmap: "<jane@example.com>", wantErr: true},
+		{name: "unterminated email", mailmap: "Jane Doe <jane@example.com", wantErr: true},
+		{name: "no email", mailmap: "Jane Doe", wantErr: true},
+		{name: "trailing name", mailmap: "Jane Doe <jane@example.com> jd", wantErr: true},
+		{name: "three emails", mailmap: "<a@x> <b@x> <c@x>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMailmap(strings.NewReader(tt.mailmap))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMailmap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMailmap() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMailmapLineNumber(t *testing.T) {
+	_, err := ParseMailmap(strings.NewReader("Jane Doe <jane@example.com>\n\nJoe <joe@example.com"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("ParseMailmap() error = %v, want it to point at line 3", err)
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+type BackfillStatus string
+
+const (
+	BackfillRunning   BackfillStatus = "running"
+	BackfillCompleted BackfillStatus = "completed"
+)
+
+// BackfillCheckpoint records how far a historical backfill of a repository has progressed,
+// so an interrupted run resumes from the next page instead of starting over.
+type BackfillCheckpoint struct {
+	Since          time.Time      `json:"since"` // Zero when the backfill starts from the first commit.
+	Until          time.Time      `json:"until"` // Pinned to the start of the backfill when no end date was given, to keep pages stable.
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	Host           string         `json:"host,omitempty"`
+	OwnerName      string         `json:"ownerName"`
+	RepoName       string         `json:"repoName"`
+	Branch         string         `json:"branch"`
+	Status         BackfillStatus `json:"status"`
+	NextCursor     string         `json:"nextCursor,omitempty"` // Set instead of paging by number when the fetcher pages by cursor.
+	NextPage       int            `json:"nextPage"`
+	CommitsWritten int            `json:"commitsWritten"`
+}
+
+// Covers reports whether the checkpoint was taken for the window described by filters.
+func (b BackfillCheckpoint) Covers(filters CommitFilters) bool {
+	var since time.Time
+	if filters.FromDate != nil {
+		since = filters.FromDate.Time
+	}
+
+	if !b.Since.Equal(since) {
+		return false
+	}
+
+	return filters.ToDate == nil || b.Until.Equal(filters.ToDate.Time)
+}
+
+// Filters returns the commit filters to resume the backfill with.
+func (b BackfillCheckpoint) Filters() CommitFilters {
+	filters := CommitFilters{
+		OwnerAndRepoName: OwnerAndRepoName{
+			Host:      b.Host,
+			OwnerName: b.OwnerName,
+			RepoName:  b.RepoName,
+		},
+		Branch: b.Branch,
+		ToDate: &Date{b.Until},
+	}
+
+	if !b.Since.IsZero() {
+		filters.FromDate = &Date{b.Since}
+	}
+
+	return filters
+}
+
+type SyncStatus struct {
+	LastErrorAt   *time.Time            `json:"lastErrorAt,omitempty"`
+	Cursors       []*SyncCursor         `json:"data"`
+	Backfills     []*BackfillCheckpoint `json:"backfills"`
+	MonitorStatus MonitorStatus         `json:"monitorStatus,omitempty"` // Empty when the repository isn't monitored.
+	LastError     string                `json:"lastError,omitempty"`
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBackfillCheckpointCovers(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		checkpoint BackfillCheckpoint
+		filters    CommitFilters
+		want       bool
+	}{
+		{
+			name:       "same window",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{FromDate: &Date{since}, ToDate: &Date{until}},
+			want:       true,
+		},
+		{
+			name:       "same window in another zone",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{FromDate: &Date{since.In(time.FixedZone("WAT", 3600))}, ToDate: &Date{until}},
+			want:       true,
+		},
+		{
+			name:       "pinned end",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{FromDate: &Date{since}},
+			want:       true,
+		},
+		{
+			name:       "from the first commit",
+			checkpoint: BackfillCheckpoint{Until: until},
+			filters:    CommitFilters{ToDate: &Date{until}},
+			want:       true,
+		},
+		{
+			name:       "other start",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{FromDate: &Date{since.AddDate(0, 0, 1)}, ToDate: &Date{until}},
+		},
+		{
+			name:       "start given for a backfill from the first commit",
+			checkpoint: BackfillCheckpoint{Until: until},
+			filters:    CommitFilters{FromDate: &Date{since}},
+		},
+		{
+			name:       "no start given for a backfill from a date",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{},
+		},
+		{
+			name:       "other end",
+			checkpoint: BackfillCheckpoint{Since: since, Until: until},
+			filters:    CommitFilters{FromDate: &Date{since}, ToDate: &Date{until.AddDate(0, 1, 0)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.checkpoint.Covers(tt.filters); got != tt.want {
+				t.Errorf("Covers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackfillCheckpointFilters(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	checkpoint := BackfillCheckpoint{Since: since, Until: until, Host: "ghe", OwnerName: "o", RepoName: "r", Branch: "main"}
+
+	filters := checkpoint.Filters()
+	if !checkpoint.Covers(filters) {
+		t.Errorf("Covers(Filters()) = false, want true")
+	}
+
+	want := CommitFilters{
+		OwnerAndRepoName: OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"},
+		Branch:           "main",
+		FromDate:         &Date{since},
+		ToDate:           &Date{until},
+	}
+	if !reflect.DeepEqual(filters, want) {
+		t.Errorf("Filters() = %+v, want %+v", filters, want)
+	}
+
+	checkpoint.Since = time.Time{}
+	if filters = checkpoint.Filters(); filters.FromDate != nil || !checkpoint.Covers(filters) {
+		t.Errorf("Filters() = %+v, want no start", filters)
+	}
+}
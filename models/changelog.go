@@ -0,0 +1,75 @@
+package models
+
+import "sort"
+
+// ChangelogBreaking is the type of the changelog section listing breaking changes, whatever their own type.
+const ChangelogBreaking = "breaking"
+
+// changelogTitles orders the well known Conventional Commits types in a changelog, other types follow alphabetically.
+var changelogTitles = []struct{ kind, title string }{
+	{ChangelogBreaking, "Breaking Changes"},
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"perf", "Performance Improvements"},
+	{"revert", "Reverts"},
+	{"refactor", "Code Refactoring"},
+	{"docs", "Documentation"},
+	{"style", "Styles"},
+	{"test", "Tests"},
+	{"build", "Build System"},
+	{"ci", "Continuous Integration"},
+	{"chore", "Chores"},
+}
+
+type ChangelogSection struct {
+	Type    string    `json:"type"`
+	Title   string    `json:"title"`
+	Commits []*Commit `json:"commits"`
+}
+
+// ChangelogParams selects the commits of a changelog, either by date window or by SHA range.
+// A SHA range holds the commits reachable from ToSHA that are not reachable from FromSHA.
+type ChangelogParams struct {
+	FromDate         *Date `json:"fromDate"`
+	ToDate           *Date `json:"toDate"`
+	OwnerAndRepoName `json:",inline"`
+	Branch           string `json:"branch"`
+	FromSHA          string `json:"fromSha"`
+	ToSHA            string `json:"toSha"`
+}
+
+// NewChangelog groups the conventional commits among commits into sections, keeping the commits' order within each.
+// Breaking changes are listed both in their own section and under their type; other commits are left out.
+func NewChangelog(commits []*Commit) []*ChangelogSection {
+	byType := make(map[string][]*Commit)
+	for _, commit := range commits {
+		if commit.Conventional == nil {
+			continue
+		}
+
+		if commit.Conventional.Breaking {
+			byType[ChangelogBreaking] = append(byType[ChangelogBreaking], commit)
+		}
+		byType[commit.Conventional.Type] = append(byType[commit.Conventional.Type], commit)
+	}
+
+	sections := make([]*ChangelogSection, 0, len(byType))
+	for _, known := range changelogTitles {
+		if list, ok := byType[known.kind]; ok {
+			sections = append(sections, &ChangelogSection{Type: known.kind, Title: known.title, Commits: list})
+			delete(byType, known.kind)
+		}
+	}
+
+	others := make([]string, 0, len(byType))
+	for kind := range byType {
+		others = append(others, kind)
+	}
+	sort.Strings(others)
+
+	for _, kind := range others {
+		sections = append(sections, &ChangelogSection{Type: kind, Title: kind, Commits: byType[kind]})
+	}
+
+	return sections
+}
@@ -0,0 +1,84 @@
+package models
+
+import "time"
+
+// CI conclusions of a commit, which are also the values of the CIConclusion filter of CommitFilters.
+const (
+	CIConclusionSuccess = "success"
+	CIConclusionFailure = "failure"
+	CIConclusionPending = "pending"
+	CIConclusionNone    = "none" // No CI reported on the commit.
+)
+
+// Check run states, in the terms of GitHub.
+const (
+	CheckRunQueued     = "queued"
+	CheckRunInProgress = "in_progress"
+	CheckRunCompleted  = "completed"
+)
+
+// CommitChecks is what CI reported on a commit, through commit statuses and check runs.
+type CommitChecks struct {
+	UpdatedAt  time.Time       `json:"updatedAt"`
+	Host       string          `json:"host,omitempty"`
+	OwnerName  string          `json:"ownerName"`
+	RepoName   string          `json:"repoName"`
+	SHA        string          `json:"sha"`
+	State      string          `json:"state"`      // Combined state of the statuses, e.g. success or pending, empty without statuses.
+	Conclusion string          `json:"conclusion"` // Overall outcome of statuses and check runs, see CIConclusionSuccess.
+	Statuses   []*CommitStatus `json:"statuses"`
+	CheckRuns  []*CheckRun     `json:"checkRuns"`
+}
+
+// CommitStatus is the latest status a CI context reported on a commit.
+type CommitStatus struct {
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	Context     string     `json:"context"`
+	State       string     `json:"state"` // error, failure, pending or success.
+	Description string     `json:"description"`
+	TargetURL   string     `json:"targetUrl"`
+}
+
+type CheckRun struct {
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`     // queued, in_progress or completed.
+	Conclusion  string     `json:"conclusion"` // e.g. success, failure or skipped, empty until completed.
+	DetailsURL  string     `json:"detailsUrl"`
+}
+
+// Conclude sets the overall conclusion of the checks: failure when any status or check run failed, pending while
+// any is still running, success once all of them passed.
+func (c *CommitChecks) Conclude() {
+	failed, pending := false, false
+	for _, status := range c.Statuses {
+		switch status.State {
+		case "error", "failure":
+			failed = true
+		case "pending":
+			pending = true
+		}
+	}
+
+	for _, run := range c.CheckRuns {
+		switch {
+		case run.Status != CheckRunCompleted:
+			pending = true
+		case run.Conclusion == "failure" || run.Conclusion == "timed_out" || run.Conclusion == "cancelled" ||
+			run.Conclusion == "action_required" || run.Conclusion == "startup_failure":
+			failed = true
+		}
+	}
+
+	switch {
+	case failed:
+		c.Conclusion = CIConclusionFailure
+	case pending:
+		c.Conclusion = CIConclusionPending
+	case len(c.Statuses) == 0 && len(c.CheckRuns) == 0:
+		c.Conclusion = CIConclusionNone
+	default:
+		c.Conclusion = CIConclusionSuccess
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import "testing"
+
+func TestCommitChecksConclude(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []string    // States of the statuses.
+		checkRuns [][2]string // Status and conclusion of the check runs.
+		want      string
+	}{
+		{name: "nothing reported", want: CIConclusionNone},
+		{name: "statuses passed", statuses: []string{"success", "success"}, want: CIConclusionSuccess},
+		{name: "status pending", statuses: []string{"success", "pending"}, want: CIConclusionPending},
+		{name: "status failed", statuses: []string{"failure", "pending"}, want: CIConclusionFailure},
+		{name: "status errored", statuses: []string{"error"}, want: CIConclusionFailure},
+		{
+			name:      "check runs passed",
+			checkRuns: [][2]string{{CheckRunCompleted, "success"}, {CheckRunCompleted, "skipped"}, {CheckRunCompleted, "neutral"}},
+			want:      CIConclusionSuccess,
+		},
+		{name: "check run queued", checkRuns: [][2]string{{CheckRunQueued, ""}}, want: CIConclusionPending},
+		{name: "check run in progress", checkRuns: [][2]string{{CheckRunInProgress, ""}}, want: CIConclusionPending},
+		{name: "check run failed", checkRuns: [][2]string{{CheckRunCompleted, "failure"}}, want: CIConclusionFailure},
+		{name: "check run timed out", checkRuns: [][2]string{{CheckRunCompleted, "timed_out"}}, want: CIConclusionFailure},
+		{name: "check run cancelled", checkRuns: [][2]string{{CheckRunCompleted, "cancelled"}}, want: CIConclusionFailure},
+		{name: "check run needs action", checkRuns: [][2]string{{CheckRunCompleted, "action_required"}}, want: CIConclusionFailure},
+		{name: "check run failed to start", checkRuns: [][2]string{{CheckRunCompleted, "startup_failure"}}, want: CIConclusionFailure},
+		{
+			name:      "failure wins over pending",
+			statuses:  []string{"pending"},
+			checkRuns: [][2]string{{CheckRunCompleted, "failure"}},
+			want:      CIConclusionFailure,
+		},
+		{
+			name:      "statuses and check runs passed",
+			statuses:  []string{"success"},
+			checkRuns: [][2]string{{CheckRunCompleted, "success"}},
+			want:      CIConclusionSuccess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := &CommitChecks{}
+			for _, state := range tt.statuses {
+				checks.Statuses = append(checks.Statuses, &CommitStatus{State: state})
+			}
+			for _, run := range tt.checkRuns {
+				checks.CheckRuns = append(checks.CheckRuns, &CheckRun{Status: run[0], Conclusion: run[1]})
+			}
+
+			checks.Conclude()
+			if checks.Conclusion != tt.want {
+				t.Errorf("Conclude() = %v, want %v", checks.Conclusion, tt.want)
+			}
+		})
+	}
+}
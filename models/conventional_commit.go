@@ -0,0 +1,36 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ConventionalCommit is the classification of a commit message following https://www.conventionalcommits.org.
+type ConventionalCommit struct {
+	Type     string `json:"type"`
+	Scope    string `json:"scope,omitempty"`
+	Subject  string `json:"subject"`
+	Breaking bool   `json:"breaking"`
+}
+
+var (
+	conventionalHeader = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?:\s+(.+)$`)
+	breakingFooter     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE:`)
+)
+
+// ParseConventionalCommit classifies message, returning nil when its subject line isn't a conventional commit header.
+// Types are lower-cased so "Feat:" and "feat:" land in the same changelog section.
+func ParseConventionalCommit(message string) *ConventionalCommit {
+	header, body, _ := strings.Cut(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+	match := conventionalHeader.FindStringSubmatch(strings.TrimSpace(header))
+	if match == nil {
+		return nil
+	}
+
+	return &ConventionalCommit{
+		Type:     strings.ToLower(match[1]),
+		Scope:    strings.TrimSpace(match[2]),
+		Subject:  strings.TrimSpace(match[4]),
+		Breaking: match[3] == "!" || breakingFooter.MatchString(body),
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    *ConventionalCommit
+	}{
+		{
+			name:    "type",
+			message: "fix: handle empty pages",
+			want:    &ConventionalCommit{Type: "fix", Subject: "handle empty pages"},
+		},
+		{
+			name:    "scope",
+			message: "feat(api): list commits between refs\n\nWith a recursive query.",
+			want:    &ConventionalCommit{Type: "feat", Scope: "api", Subject: "list commits between refs"},
+		},
+		{
+			name:    "upper case type",
+			message: "Feat: add changelogs",
+			want:    &ConventionalCommit{Type: "feat", Subject: "add changelogs"},
+		},
+		{
+			name:    "breaking marker",
+			message: "refactor(store)!: drop the legacy table",
+			want:    &ConventionalCommit{Type: "refactor", Scope: "store", Subject: "drop the legacy table", Breaking: true},
+		},
+		{
+			name:    "breaking change footer",
+			message: "feat: rename the fetcher option\n\nBREAKING CHANGE: fetcher replaces mode.",
+			want:    &ConventionalCommit{Type: "feat", Subject: "rename the fetcher option", Breaking: true},
+		},
+		{
+			name:    "breaking-change footer with crlf",
+			message: "feat: rename the fetcher option\r\n\r\nBREAKING-CHANGE: fetcher replaces mode.",
+			want:    &ConventionalCommit{Type: "feat", Subject: "rename the fetcher option", Breaking: true},
+		},
+		{
+			name:    "footer mentioned in prose",
+			message: "docs: explain footers\n\nA BREAKING CHANGE: footer marks breaking changes.",
+			want:    &ConventionalCommit{Type: "docs", Subject: "explain footers"},
+		},
+		{name: "plain subject", message: "Fix the parser"},
+		{name: "no space after colon", message: "fix:handle empty pages"},
+		{name: "empty subject", message: "fix: "},
+		{name: "merge commit", message: "Merge pull request #12 from o/feature"},
+		{name: "header on the second line", message: "Fix the parser\nfix: handle empty pages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseConventionalCommit(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseConventionalCommit() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,26 +1,122 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	validation "github.com/go-ozzo/ozzo-validation"
+	"path"
+	"time"
+)
+
+// WebhookPollingIntervalInHours is how often repositories that receive push webhooks are still polled, to catch missed deliveries.
+const WebhookPollingIntervalInHours = 24
+
+// Fetchers commit history can be listed with. GraphQL lists commits along with their line stats and pull requests
+// in one query per page, but is only offered by GitHub.
+const (
+	FetcherREST    = "rest"
+	FetcherGraphQL = "graphql"
+)
+
+// MonitorStatus is the outcome of the last scheduled sync of a monitored repository.
+type MonitorStatus string
+
+const (
+	MonitorPending MonitorStatus = "pending" // Not synced on schedule yet.
+	MonitorHealthy MonitorStatus = "healthy"
+	// MonitorDegraded is set when the source kept failing with errors that may go away by themselves, e.g. outages.
+	MonitorDegraded MonitorStatus = "degraded"
+	// MonitorFailed is set when the source reported the repository gone or refused our credentials,
+	// which won't change until someone acts on it. The repository is still synced on schedule.
+	MonitorFailed MonitorStatus = "failed"
 )
 
 type MonitorRepositoryCommitConfig struct {
-	RepoName        string `json:"repoName"`
-	OwnerName       string `json:"ownerName"`
-	FromDate        string `json:"fromDate"`
-	ToDate          string `json:"toDate"`
-	DurationInHours int64  `json:"durationInHours"`
+	LastErrorAt     *time.Time    `json:"lastErrorAt,omitempty"` // When the sync that failed with LastError ran.
+	Host            string        `json:"host"`                  // Name of the configured host the repository lives on, empty for github.com.
+	Provider        string        `json:"provider"`              // Provider of the host, see ProviderGithub. Empty means github.
+	RepoName        string        `json:"repoName"`
+	OwnerName       string        `json:"ownerName"`
+	FromDate        string        `json:"fromDate"`
+	ToDate          string        `json:"toDate"`
+	Branches        []string      `json:"branches"` // Branch names or globs like release/*, empty for the default branch.
+	WebhookSecret   string        `json:"-"`        // Verifies push webhooks for the repository, empty when it doesn't send any.
+	Fetcher         string        `json:"fetcher"`  // How history is listed, see FetcherGraphQL. Empty means rest.
+	Status          MonitorStatus `json:"status"`
+	LastError       string        `json:"lastError,omitempty"` // Error of the last failed sync, cleared once one succeeds.
+	SourceID        int64         `json:"sourceId,omitempty"`  // Immutable ID of the repository on its source, set once it was synced.
+	DurationInHours int64         `json:"durationInHours"`
 }
 
+// ID identifies the monitor, and its job, by the source ID of the repository once known, so it survives renames
+// and transfers. Until the first sync it goes by the name of the repository.
 func (c MonitorRepositoryCommitConfig) ID() string {
+	if c.SourceID != 0 {
+		return fmt.Sprintf("%s#%d", c.Host, c.SourceID)
+	}
+	if c.Host != "" {
+		return fmt.Sprintf("%s/%s/%s", c.Host, c.RepoName, c.OwnerName)
+	}
 	return fmt.Sprintf("%s/%s", c.RepoName, c.OwnerName)
 }
 
+// OwnerAndRepoName returns the identity of the monitored repository.
+func (c MonitorRepositoryCommitConfig) OwnerAndRepoName() OwnerAndRepoName {
+	return OwnerAndRepoName{
+		Host:      c.Host,
+		OwnerName: c.OwnerName,
+		RepoName:  c.RepoName,
+	}
+}
+
+// PollingInterval is how often the repository is synced. Pushes to webhook enabled repositories reach us as they
+// happen, so those are only polled at a long interval.
+func (c MonitorRepositoryCommitConfig) PollingInterval() time.Duration {
+	hours := c.DurationInHours
+	if c.WebhookSecret != "" && hours < WebhookPollingIntervalInHours {
+		hours = WebhookPollingIntervalInHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// MonitorsBranch reports whether pushes to branch are mirrored, given the repository's default branch.
+func (c MonitorRepositoryCommitConfig) MonitorsBranch(branch, defaultBranch string) bool {
+	if len(c.Branches) == 0 {
+		return branch == defaultBranch
+	}
+
+	for _, pattern := range c.Branches {
+		if ok, _ := path.Match(pattern, branch); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (c MonitorRepositoryCommitConfig) Validate() error {
 	return validation.ValidateStruct(&c,
 		validation.Field(&c.OwnerName, validation.Required),
 		validation.Field(&c.RepoName, validation.Required),
 		validation.Field(&c.DurationInHours, validation.Required, validation.Min(1)),
+		validation.Field(&c.Provider, validation.In(ProviderGithub, ProviderGitlab, ProviderGitea, ProviderLocal)),
+		validation.Field(&c.Branches, validation.By(validateBranchPatterns)),
+		validation.Field(&c.Fetcher, validation.In(FetcherREST, FetcherGraphQL), validation.By(c.validateFetcher)),
 	)
 }
+
+func (c MonitorRepositoryCommitConfig) validateFetcher(value interface{}) error {
+	if fetcher, _ := value.(string); fetcher == FetcherGraphQL && c.Provider != ProviderGithub && c.Provider != "" {
+		return errors.New("the graphql fetcher is only available on github hosts")
+	}
+	return nil
+}
+
+func validateBranchPatterns(value interface{}) error {
+	patterns, _ := value.([]string)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid branch pattern %q", pattern)
+		}
+	}
+	return nil
+}
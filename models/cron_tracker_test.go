@@ -0,0 +1,24 @@
+package models
+
+import "testing"
+
+func TestMonitorRepositoryCommitConfigID(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  MonitorRepositoryCommitConfig
+		want string
+	}{
+		{"github.com", MonitorRepositoryCommitConfig{OwnerName: "o", RepoName: "r"}, "r/o"},
+		{"configured host", MonitorRepositoryCommitConfig{Host: "ghe", OwnerName: "o", RepoName: "r"}, "ghe/r/o"},
+		{"source ID", MonitorRepositoryCommitConfig{Host: "ghe", OwnerName: "o", RepoName: "r", SourceID: 7}, "ghe#7"},
+		{"renamed", MonitorRepositoryCommitConfig{Host: "ghe", OwnerName: "o2", RepoName: "r2", SourceID: 7}, "ghe#7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.ID(); got != tt.want {
+				t.Errorf("ID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ type Result struct {
 }
 
 type OwnerAndRepoName struct {
+	Host      string `json:"host,omitempty" schema:"host"` // Name of the configured host the repository lives on, empty for github.com.
 	OwnerName string `json:"ownerName" schema:"ownerName"`
 	RepoName  string `json:"repoName" schema:"repoName"`
 }
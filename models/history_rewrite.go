@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// HistoryRewrite is an incident where a branch head moved to a commit that doesn't descend from
+// the head we last mirrored, e.g. after a force-push or a hard reset.
+type HistoryRewrite struct {
+	DetectedAt   time.Time `json:"detectedAt"`
+	Host         string    `json:"host,omitempty"`
+	OwnerName    string    `json:"ownerName"`
+	RepoName     string    `json:"repoName"`
+	Branch       string    `json:"branch"`
+	OldHeadSHA   string    `json:"oldHeadSha"`
+	NewHeadSHA   string    `json:"newHeadSha"`
+	OrphanedSHAs []string  `json:"orphanedShas"` // Mirrored commits that are no longer reachable from the branch.
+	ID           int64     `json:"id"`
+}
@@ -2,26 +2,78 @@ package models
 
 import "time"
 
+// Commit is dated by its committer, which is when it landed in the history. Author is the author's name.
 type Commit struct {
-	Date            time.Time `json:"date"`
-	Message         string    `json:"message"`
-	Author          string    `json:"author"`
-	RepoName        string    `json:"repoName"`
-	OwnerName       string    `json:"ownerName"`
-	URL             string    `json:"url"`
-	SHA             string    `json:"sha"`
-	ParentCommitIDs []string  `json:"parentCommitIDs"`
+	Date            time.Time        `json:"date"`
+	AuthorDate      time.Time        `json:"authorDate"`
+	Message         string           `json:"message"`
+	Author          string           `json:"author"`
+	AuthorEmail     string           `json:"authorEmail"`
+	AuthorLogin     string           `json:"authorLogin"` // GitHub account linked to the author email, empty when there is none.
+	CommitterName   string           `json:"committerName"`
+	CommitterEmail  string           `json:"committerEmail"`
+	CommitterLogin  string           `json:"committerLogin"`
+	Host            string           `json:"host,omitempty"`
+	RepoName        string           `json:"repoName"`
+	OwnerName       string           `json:"ownerName"`
+	URL             string           `json:"url"`
+	SHA             string           `json:"sha"`
+	ParentCommitIDs []string         `json:"parentCommitIDs"`
+	Additions       int              `json:"additions"`
+	Deletions       int              `json:"deletions"`
+	AuthorID        int64            `json:"authorId"`
+	CommitterID     int64            `json:"committerId"`
+	Branches        []string         `json:"branches"` // Mirrored branches that contain the commit.
+	Trailers        []*CommitTrailer `json:"trailers"`
+	// Set when the message follows Conventional Commits.
+	Conventional *ConventionalCommit `json:"conventional,omitempty"`
+	Verification *CommitVerification `json:"verification,omitempty"`
+	// Every monitored repository that contains the commit, e.g. a fork and its upstream.
+	Repositories []OwnerAndRepoName `json:"repositories,omitempty"`
+	Unreachable  bool               `json:"unreachable"` // No mirrored branch reaches the commit anymore, see HistoryRewrite.
+	// Pull requests the commit was pushed to, only known when it was listed by a fetcher that reports them.
+	PullRequests []*PullRequest `json:"pullRequests,omitempty"`
+}
+
+// CommitFile is a file changed by a commit, as reported by the single commit API.
+type CommitFile struct {
+	SHA              string `json:"sha"`
+	Filename         string `json:"filename"`
+	Status           string `json:"status"` // added, removed, modified, renamed, copied, changed or unchanged.
+	PreviousFilename string `json:"previousFilename,omitempty"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
 }
 
 type CommitFilters struct {
 	FromDate         *Date `json:"fromDate" schema:"fromDate,omitempty"`
 	ToDate           *Date `json:"toDate" schema:"toDate,omitempty"`
 	OwnerAndRepoName `json:",inline" schema:",inline"`
-	Limit            int64 `json:"limit" schema:"limit,omitempty"`
-	Page             int64 `json:"page" schema:"page,omitempty"`
+	Branch           string `json:"branch" schema:"branch,omitempty"`
+	Author           string `json:"author" schema:"author,omitempty"`                     // Matches the author's name, email or login.
+	Committer        string `json:"committer" schema:"committer,omitempty"`               // Matches the committer's name, email or login.
+	GroupBy          string `json:"groupBy" schema:"groupBy,omitempty"`                   // Identity key of top commit authors, see AuthorGroupBy.
+	Type             string `json:"type" schema:"type,omitempty"`                         // Conventional Commits type, e.g. feat or fix.
+	Scope            string `json:"scope" schema:"scope,omitempty"`                       // Conventional Commits scope.
+	Verification     string `json:"verification" schema:"verification,omitempty"`         // Signature state, see VerificationVerified.
+	CIConclusion     string `json:"ciConclusion" schema:"ciConclusion,omitempty"`         // Outcome of CI on the commit, see CIConclusionSuccess.
+	IncludeCoAuthors bool   `json:"includeCoAuthors" schema:"includeCoAuthors,omitempty"` // Credit Co-authored-by trailers in top commit authors.
+	BreakingOnly     bool   `json:"breakingOnly" schema:"breakingOnly,omitempty"`         // Only conventional commits flagged as breaking changes.
+	Fetcher          string `json:"-" schema:"-"`                                         // How commits are listed when syncing, see FetcherGraphQL.
+	Limit            int64  `json:"limit" schema:"limit,omitempty"`
+	Page             int64  `json:"page" schema:"page,omitempty"`
 }
 
+// AuthorGroupBy values select which part of the author identity top commit authors are counted by.
+const (
+	AuthorGroupByName  = "name"
+	AuthorGroupByEmail = "email"
+	AuthorGroupByLogin = "login"
+)
+
 type TopCommitAuthor struct {
-	Author      string `json:"author"`
-	CommitCount int    `json:"commitsCount"`
+	Author          string `json:"author"`
+	CommitCount     int    `json:"commitsCount"`
+	CoAuthoredCount int    `json:"coAuthoredCount"` // Commits in CommitCount credited through a Co-authored-by trailer.
 }
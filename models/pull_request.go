@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+const (
+	PullRequestOpen   = "open"
+	PullRequestClosed = "closed"
+	PullRequestMerged = "merged"
+)
+
+// PullRequest is a pull request a mirrored commit was pushed to.
+type PullRequest struct {
+	MergedAt   *time.Time `json:"mergedAt,omitempty"` // Nil until the pull request is merged.
+	Title      string     `json:"title"`
+	State      string     `json:"state"` // open, closed or merged.
+	URL        string     `json:"url"`
+	Author     string     `json:"author"` // Login of the account that opened the pull request.
+	BaseBranch string     `json:"baseBranch"`
+	HeadBranch string     `json:"headBranch"`
+	Number     int        `json:"number"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Tag is a tag of a mirrored repository, along with the commit it points at, peeled from annotated tags.
+type Tag struct {
+	Host      string `json:"host,omitempty"`
+	OwnerName string `json:"ownerName"`
+	RepoName  string `json:"repoName"`
+	Name      string `json:"name"`
+	SHA       string `json:"sha"`
+}
+
+// Release is a release published on the source for a tag of a mirrored repository.
+type Release struct {
+	PublishedAt *time.Time `json:"publishedAt,omitempty"` // Nil for drafts.
+	Host        string     `json:"host,omitempty"`
+	OwnerName   string     `json:"ownerName"`
+	RepoName    string     `json:"repoName"`
+	TagName     string     `json:"tagName"`
+	SHA         string     `json:"sha"` // Commit of the tag, empty until the tag is mirrored.
+	Name        string     `json:"name"`
+	Body        string     `json:"body"`
+	URL         string     `json:"url"`
+	Author      string     `json:"author"` // Login of the account that published the release.
+	Draft       bool       `json:"draft"`
+	Prerelease  bool       `json:"prerelease"`
+}
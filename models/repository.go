@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Repository is the metadata of a mirrored repository, refreshed from its source on every sync.
+type Repository struct {
+	PushedAt      *time.Time `json:"pushedAt,omitempty"`  // Last push, or last activity on sources that don't track pushes.
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"` // Set once the source no longer has the repository.
+	SyncedAt      time.Time  `json:"syncedAt"`
+	SourceID      int64      `json:"sourceId,omitempty"` // Immutable ID of the repository on its source, kept across renames. 0 when the source has none.
+	Host          string     `json:"host,omitempty"`
+	OwnerName     string     `json:"ownerName"`
+	RepoName      string     `json:"repoName"`
+	DefaultBranch string     `json:"defaultBranch"`
+	Visibility    string     `json:"visibility"` // public, internal or private, empty when the source has no notion of it.
+	Description   string     `json:"description"`
+	Topics        []string   `json:"topics"`
+	Stars         int        `json:"stars"`
+	Archived      bool       `json:"archived"`
+}
+
+// OwnerAndRepoName returns the identity of the repository.
+func (r Repository) OwnerAndRepoName() OwnerAndRepoName {
+	return OwnerAndRepoName{
+		Host:      r.Host,
+		OwnerName: r.OwnerName,
+		RepoName:  r.RepoName,
+	}
+}
+
+// RepositoryRename is published once the mirror of a repository renamed or transferred on its source moved to its new name.
+type RepositoryRename struct {
+	RenamedAt time.Time        `json:"renamedAt"`
+	From      OwnerAndRepoName `json:"from"`
+	To        OwnerAndRepoName `json:"to"`
+	SourceID  int64            `json:"sourceId"`
+}
@@ -0,0 +1,35 @@
+package models
+
+// CommitVerification is GitHub's verdict on a commit's signature. Reason is "unsigned" for commits without one,
+// and empty for commits mirrored before verification was tracked.
+type CommitVerification struct {
+	Reason    string `json:"reason"`
+	Signature string `json:"signature,omitempty"`
+	Verified  bool   `json:"verified"`
+}
+
+// Verification filter values for CommitFilters.
+const (
+	VerificationVerified   = "verified"
+	VerificationUnverified = "unverified" // Every commit GitHub could not verify, including unsigned ones.
+	VerificationUnsigned   = "unsigned"
+)
+
+// SignatureReport summarizes the signature verification of a repository's commits.
+type SignatureReport struct {
+	OwnerName string `json:"ownerName"`
+	RepoName  string `json:"repoName"`
+	// Commits mirrored before verification was tracked are counted as unknown and left out of SignedRatio.
+	Reasons          []*VerificationReasonCount `json:"reasons"`
+	SignedRatio      float64                    `json:"signedRatio"` // Share of the known commits that are verified.
+	TotalCommits     int                        `json:"totalCommits"`
+	VerifiedCommits  int                        `json:"verifiedCommits"`
+	UnsignedCommits  int                        `json:"unsignedCommits"`
+	UnverifiedSigned int                        `json:"unverifiedSignedCommits"` // Signed, but the signature did not verify.
+	UnknownCommits   int                        `json:"unknownCommits"`
+}
+
+type VerificationReasonCount struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
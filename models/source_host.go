@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Providers of the git hosting services commits can be mirrored from.
+const (
+	ProviderGithub = "github"
+	ProviderGitlab = "gitlab"
+	ProviderGitea  = "gitea"
+	ProviderLocal  = "local" // Bare repositories on disk.
+)
+
+// Names of the hosts repositories live on when they don't name one. Gitea and local hosts have no public instance.
+const (
+	GithubDotComHost = ""
+	GitlabDotComHost = "gitlab.com"
+)
+
+// SourceHost is a named instance of a provider that monitored repositories can live on,
+// e.g. github.com, gitlab.com, a self-hosted GitHub Enterprise, GitLab or Gitea server, or a directory of mirrors.
+type SourceHost struct {
+	Provider  string   `json:"provider"`
+	Name      string   `json:"name"`
+	BaseURL   string   `json:"baseUrl"`   // API root, e.g. https://github.example.com/api/v3/, or a path or file:// URL for local hosts.
+	UploadURL string   `json:"uploadUrl"` // GitHub Enterprise only, defaults to BaseURL.
+	Tokens    []string `json:"-"`         // Access tokens issued by this host.
+}
+
+// CircuitState is the state of the circuit breaker guarding the calls to a source host.
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"    // Calls go through.
+	CircuitOpen     CircuitState = "open"      // The host kept failing, calls fail fast until it is probed again.
+	CircuitHalfOpen CircuitState = "half_open" // A single probe call is let through to check whether the host recovered.
+)
+
+// SourceHostHealth is the state of the circuit breaker of a source host, published whenever it changes.
+type SourceHostHealth struct {
+	ChangedAt           time.Time    `json:"changedAt"`
+	Host                string       `json:"host"`
+	Provider            string       `json:"provider"`
+	State               CircuitState `json:"state"`
+	LastError           string       `json:"lastError,omitempty"` // Last failure of the host, cleared once it recovers.
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+}
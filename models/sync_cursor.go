@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SyncCursor is the newest commit mirrored for a branch of a monitored repository.
+type SyncCursor struct {
+	LastSyncedAt time.Time `json:"lastSyncedAt"`
+	Host         string    `json:"host,omitempty"`
+	OwnerName    string    `json:"ownerName"`
+	RepoName     string    `json:"repoName"`
+	Branch       string    `json:"branch"` // Empty for the repository's default branch.
+	HeadSHA      string    `json:"headSha"`
+	NewCommits   int       `json:"newCommits"` // Commits found by the last sync run.
+}
+
+type SyncResult struct {
+	HistoryRewrite *HistoryRewrite `json:"historyRewrite,omitempty"` // Set when the run found the branch rewritten.
+	HeadSHA        string          `json:"headSha"`
+	NewCommits     int             `json:"newCommits"`
+}
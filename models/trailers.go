@@ -0,0 +1,68 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Well known git trailers.
+const (
+	TrailerCoAuthoredBy = "Co-authored-by"
+	TrailerSignedOffBy  = "Signed-off-by"
+	TrailerReviewedBy   = "Reviewed-by"
+)
+
+// CommitTrailer is a "Key: value" line in the trailer block at the end of a commit message.
+// Name and Email are set when the value is an identity such as "Jane Doe <jane@example.com>".
+type CommitTrailer struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+var (
+	trailerLine   = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)\s*:\s*(.*)$`)
+	trailerPerson = regexp.MustCompile(`^(.*?)\s*<([^<>]*)>$`)
+)
+
+// ParseTrailers returns the trailers of a commit message, in order.
+//
+// Like git, trailers are only read from the last paragraph of the message, and only when every line of it
+// is a trailer or the continuation of one. The subject line alone never holds trailers.
+func ParseTrailers(message string) []*CommitTrailer {
+	trailers := make([]*CommitTrailer, 0)
+
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n\n")
+	if len(paragraphs) < 2 {
+		return trailers
+	}
+
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && len(trailers) > 0 {
+			last := trailers[len(trailers)-1]
+			last.Value = last.Value + " " + strings.TrimSpace(line)
+			continue
+		}
+
+		match := trailerLine.FindStringSubmatch(line)
+		if match == nil {
+			return make([]*CommitTrailer, 0)
+		}
+
+		trailers = append(trailers, &CommitTrailer{Key: match[1], Value: strings.TrimSpace(match[2])})
+	}
+
+	for _, trailer := range trailers {
+		if match := trailerPerson.FindStringSubmatch(trailer.Value); match != nil {
+			trailer.Name = strings.TrimSpace(match[1])
+			trailer.Email = strings.TrimSpace(match[2])
+		}
+	}
+
+	return trailers
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTrailers(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []*CommitTrailer
+	}{
+		{
+			name:    "subject only",
+			message: "Signed-off-by: Jane Doe <jane@example.com>",
+			want:    []*CommitTrailer{},
+		},
+		{
+			name:    "identities",
+			message: "Fix the parser\n\nIt choked on tabs.\n\nCo-authored-by: Jane Doe <jane@example.com>\nSigned-off-by: Joe <joe@example.com>\n",
+			want: []*CommitTrailer{
+				{Key: TrailerCoAuthoredBy, Value: "Jane Doe <jane@example.com>", Name: "Jane Doe", Email: "jane@example.com"},
+				{Key: TrailerSignedOffBy, Value: "Joe <joe@example.com>", Name: "Joe", Email: "joe@example.com"},
+			},
+		},
+		{
+			name:    "plain values",
+			message: "Fix the parser\n\nFixes: #12\nChange-Id: I8f3a",
+			want: []*CommitTrailer{
+				{Key: "Fixes", Value: "#12"},
+				{Key: "Change-Id", Value: "I8f3a"},
+			},
+		},
+		{
+			name:    "continuation lines",
+			message: "Fix the parser\n\nNote: the first line\n  and the second\nReviewed-by: Jane Doe <jane@example.com>",
+			want: []*CommitTrailer{
+				{Key: "Note", Value: "the first line and the second"},
+				{Key: TrailerReviewedBy, Value: "Jane Doe <jane@example.com>", Name: "Jane Doe", Email: "jane@example.com"},
+			},
+		},
+		{
+			name:    "crlf line endings",
+			message: "Fix the parser\r\n\r\nCo-authored-by: Jane Doe <jane@example.com>\r\n",
+			want: []*CommitTrailer{
+				{Key: TrailerCoAuthoredBy, Value: "Jane Doe <jane@example.com>", Name: "Jane Doe", Email: "jane@example.com"},
+			},
+		},
+		{
+			name:    "last paragraph isn't all trailers",
+			message: "Fix the parser\n\nCo-authored-by: Jane Doe <jane@example.com>\nand some prose",
+			want:    []*CommitTrailer{},
+		},
+		{
+			name:    "trailers above the last paragraph",
+			message: "Fix the parser\n\nCo-authored-by: Jane Doe <jane@example.com>\n\nSome prose.",
+			want:    []*CommitTrailer{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseTrailers(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTrailers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
+// API of the commit monitor, owned by this service. Regenerate pb with `make proto`.
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.34.1
@@ -62,27 +64,108 @@ func (*Void) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{0}
 }
 
+type RepositoryRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *RepositoryRef) Reset() {
+	*x = RepositoryRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRef) ProtoMessage() {}
+
+func (x *RepositoryRef) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRef.ProtoReflect.Descriptor instead.
+func (*RepositoryRef) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepositoryRef) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepositoryRef) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RepositoryRef) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
 // Define the Repo message
 type Commit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date            string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Message         string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Author          string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	RepoName        string   `protobuf:"bytes,4,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	OwnerName       string   `protobuf:"bytes,5,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	Url             string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	Sha             string   `protobuf:"bytes,7,opt,name=sha,proto3" json:"sha,omitempty"`
-	ParentCommitIDs []string `protobuf:"bytes,8,rep,name=parentCommitIDs,proto3" json:"parentCommitIDs,omitempty"`
-	Meta            string   `protobuf:"bytes,9,opt,name=meta,proto3" json:"meta,omitempty"`
+	Date            string              `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Message         string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Author          string              `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	RepoName        string              `protobuf:"bytes,4,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	OwnerName       string              `protobuf:"bytes,5,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	Url             string              `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Sha             string              `protobuf:"bytes,7,opt,name=sha,proto3" json:"sha,omitempty"`
+	ParentCommitIDs []string            `protobuf:"bytes,8,rep,name=parentCommitIDs,proto3" json:"parentCommitIDs,omitempty"`
+	Meta            string              `protobuf:"bytes,9,opt,name=meta,proto3" json:"meta,omitempty"`
+	Branches        []string            `protobuf:"bytes,10,rep,name=branches,proto3" json:"branches,omitempty"`
+	Repositories    []*RepositoryRef    `protobuf:"bytes,11,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	Additions       int64               `protobuf:"varint,12,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions       int64               `protobuf:"varint,13,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	AuthorEmail     string              `protobuf:"bytes,14,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
+	AuthorDate      string              `protobuf:"bytes,15,opt,name=authorDate,proto3" json:"authorDate,omitempty"`
+	AuthorLogin     string              `protobuf:"bytes,16,opt,name=authorLogin,proto3" json:"authorLogin,omitempty"`
+	AuthorId        int64               `protobuf:"varint,17,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	CommitterName   string              `protobuf:"bytes,18,opt,name=committerName,proto3" json:"committerName,omitempty"`
+	CommitterEmail  string              `protobuf:"bytes,19,opt,name=committerEmail,proto3" json:"committerEmail,omitempty"`
+	CommitterLogin  string              `protobuf:"bytes,20,opt,name=committerLogin,proto3" json:"committerLogin,omitempty"`
+	CommitterId     int64               `protobuf:"varint,21,opt,name=committerId,proto3" json:"committerId,omitempty"`
+	Trailers        []*CommitTrailer    `protobuf:"bytes,22,rep,name=trailers,proto3" json:"trailers,omitempty"`
+	Conventional    *ConventionalCommit `protobuf:"bytes,23,opt,name=conventional,proto3" json:"conventional,omitempty"`
+	Verification    *CommitVerification `protobuf:"bytes,24,opt,name=verification,proto3" json:"verification,omitempty"`
+	Unreachable     bool                `protobuf:"varint,25,opt,name=unreachable,proto3" json:"unreachable,omitempty"`
+	Host            string              `protobuf:"bytes,26,opt,name=host,proto3" json:"host,omitempty"`
+	PullRequests    []*PullRequest      `protobuf:"bytes,27,rep,name=pullRequests,proto3" json:"pullRequests,omitempty"`
 }
 
 func (x *Commit) Reset() {
 	*x = Commit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commits_commits_proto_msgTypes[1]
+		mi := &file_commits_commits_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -95,7 +178,7 @@ func (x *Commit) String() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_commits_commits_proto_msgTypes[1]
+	mi := &file_commits_commits_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +191,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_commits_commits_proto_rawDescGZIP(), []int{1}
+	return file_commits_commits_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Commit) GetDate() string {
@@ -174,76 +257,149 @@ func (x *Commit) GetMeta() string {
 	return ""
 }
 
-type TopCommitAuthor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Commit) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
 
-	Author       string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	CommitsCount int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+func (x *Commit) GetRepositories() []*RepositoryRef {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
 }
 
-func (x *TopCommitAuthor) Reset() {
-	*x = TopCommitAuthor{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_commits_commits_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Commit) GetAdditions() int64 {
+	if x != nil {
+		return x.Additions
 	}
+	return 0
 }
 
-func (x *TopCommitAuthor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Commit) GetDeletions() int64 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
 }
 
-func (*TopCommitAuthor) ProtoMessage() {}
+func (x *Commit) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
 
-func (x *TopCommitAuthor) ProtoReflect() protoreflect.Message {
-	mi := &file_commits_commits_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Commit) GetAuthorDate() string {
+	if x != nil {
+		return x.AuthorDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TopCommitAuthor.ProtoReflect.Descriptor instead.
-func (*TopCommitAuthor) Descriptor() ([]byte, []int) {
-	return file_commits_commits_proto_rawDescGZIP(), []int{2}
+func (x *Commit) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
 }
 
-func (x *TopCommitAuthor) GetAuthor() string {
+func (x *Commit) GetAuthorId() int64 {
 	if x != nil {
-		return x.Author
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Commit) GetCommitterName() string {
+	if x != nil {
+		return x.CommitterName
 	}
 	return ""
 }
 
-func (x *TopCommitAuthor) GetCommitsCount() int64 {
+func (x *Commit) GetCommitterEmail() string {
 	if x != nil {
-		return x.CommitsCount
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *Commit) GetCommitterLogin() string {
+	if x != nil {
+		return x.CommitterLogin
+	}
+	return ""
+}
+
+func (x *Commit) GetCommitterId() int64 {
+	if x != nil {
+		return x.CommitterId
 	}
 	return 0
 }
 
-type CommitFilterParams struct {
+func (x *Commit) GetTrailers() []*CommitTrailer {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *Commit) GetConventional() *ConventionalCommit {
+	if x != nil {
+		return x.Conventional
+	}
+	return nil
+}
+
+func (x *Commit) GetVerification() *CommitVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *Commit) GetUnreachable() bool {
+	if x != nil {
+		return x.Unreachable
+	}
+	return false
+}
+
+func (x *Commit) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Commit) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type PullRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page      int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit     int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	OwnerName string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	RepoName  string `protobuf:"bytes,4,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	FromDate  string `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
-	ToDate    string `protobuf:"bytes,6,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	Number     int64  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Title      string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	State      string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Url        string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Author     string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	BaseBranch string `protobuf:"bytes,6,opt,name=baseBranch,proto3" json:"baseBranch,omitempty"`
+	HeadBranch string `protobuf:"bytes,7,opt,name=headBranch,proto3" json:"headBranch,omitempty"`
+	MergedAt   string `protobuf:"bytes,8,opt,name=mergedAt,proto3" json:"mergedAt,omitempty"`
 }
 
-func (x *CommitFilterParams) Reset() {
-	*x = CommitFilterParams{}
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,13 +407,13 @@ func (x *CommitFilterParams) Reset() {
 	}
 }
 
-func (x *CommitFilterParams) String() string {
+func (x *PullRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitFilterParams) ProtoMessage() {}
+func (*PullRequest) ProtoMessage() {}
 
-func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,65 +425,80 @@ func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitFilterParams.ProtoReflect.Descriptor instead.
-func (*CommitFilterParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CommitFilterParams) GetPage() int64 {
+func (x *PullRequest) GetNumber() int64 {
 	if x != nil {
-		return x.Page
+		return x.Number
 	}
 	return 0
 }
 
-func (x *CommitFilterParams) GetLimit() int64 {
+func (x *PullRequest) GetTitle() string {
 	if x != nil {
-		return x.Limit
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *CommitFilterParams) GetOwnerName() string {
+func (x *PullRequest) GetState() string {
 	if x != nil {
-		return x.OwnerName
+		return x.State
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetRepoName() string {
+func (x *PullRequest) GetUrl() string {
 	if x != nil {
-		return x.RepoName
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetFromDate() string {
+func (x *PullRequest) GetAuthor() string {
 	if x != nil {
-		return x.FromDate
+		return x.Author
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetToDate() string {
+func (x *PullRequest) GetBaseBranch() string {
 	if x != nil {
-		return x.ToDate
+		return x.BaseBranch
 	}
 	return ""
 }
 
-type CommitByOwnerAndShaParams struct {
+func (x *PullRequest) GetHeadBranch() string {
+	if x != nil {
+		return x.HeadBranch
+	}
+	return ""
+}
+
+func (x *PullRequest) GetMergedAt() string {
+	if x != nil {
+		return x.MergedAt
+	}
+	return ""
+}
+
+type PullRequestParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
 	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	Sha       string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Number    int64  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (x *CommitByOwnerAndShaParams) Reset() {
-	*x = CommitByOwnerAndShaParams{}
+func (x *PullRequestParams) Reset() {
+	*x = PullRequestParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +506,13 @@ func (x *CommitByOwnerAndShaParams) Reset() {
 	}
 }
 
-func (x *CommitByOwnerAndShaParams) String() string {
+func (x *PullRequestParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitByOwnerAndShaParams) ProtoMessage() {}
+func (*PullRequestParams) ProtoMessage() {}
 
-func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
+func (x *PullRequestParams) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,42 +524,51 @@ func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitByOwnerAndShaParams.ProtoReflect.Descriptor instead.
-func (*CommitByOwnerAndShaParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullRequestParams.ProtoReflect.Descriptor instead.
+func (*PullRequestParams) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CommitByOwnerAndShaParams) GetOwnerName() string {
+func (x *PullRequestParams) GetOwnerName() string {
 	if x != nil {
 		return x.OwnerName
 	}
 	return ""
 }
 
-func (x *CommitByOwnerAndShaParams) GetRepoName() string {
+func (x *PullRequestParams) GetRepoName() string {
 	if x != nil {
 		return x.RepoName
 	}
 	return ""
 }
 
-func (x *CommitByOwnerAndShaParams) GetSha() string {
+func (x *PullRequestParams) GetHost() string {
 	if x != nil {
-		return x.Sha
+		return x.Host
 	}
 	return ""
 }
 
-type HealthCheckResponse struct {
+func (x *PullRequestParams) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type CommitVerification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int64 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Verified  bool   `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *CommitVerification) Reset() {
+	*x = CommitVerification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,13 +576,13 @@ func (x *HealthCheckResponse) Reset() {
 	}
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *CommitVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*CommitVerification) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *CommitVerification) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,28 +594,45 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitVerification.ProtoReflect.Descriptor instead.
+func (*CommitVerification) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HealthCheckResponse) GetCode() int64 {
+func (x *CommitVerification) GetVerified() bool {
 	if x != nil {
-		return x.Code
+		return x.Verified
 	}
-	return 0
+	return false
 }
 
-type ListCommitResponse struct {
+func (x *CommitVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CommitVerification) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ConventionalCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Commit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Scope    string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Subject  string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Breaking bool   `protobuf:"varint,4,opt,name=breaking,proto3" json:"breaking,omitempty"`
 }
 
-func (x *ListCommitResponse) Reset() {
-	*x = ListCommitResponse{}
+func (x *ConventionalCommit) Reset() {
+	*x = ConventionalCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,13 +640,13 @@ func (x *ListCommitResponse) Reset() {
 	}
 }
 
-func (x *ListCommitResponse) String() string {
+func (x *ConventionalCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommitResponse) ProtoMessage() {}
+func (*ConventionalCommit) ProtoMessage() {}
 
-func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
+func (x *ConventionalCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,28 +658,52 @@ func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommitResponse.ProtoReflect.Descriptor instead.
-func (*ListCommitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConventionalCommit.ProtoReflect.Descriptor instead.
+func (*ConventionalCommit) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListCommitResponse) GetData() []*Commit {
+func (x *ConventionalCommit) GetType() string {
 	if x != nil {
-		return x.Data
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type ListTopCommitAuthorResponse struct {
+func (x *ConventionalCommit) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ConventionalCommit) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ConventionalCommit) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+type CommitTrailer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*TopCommitAuthor `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *ListTopCommitAuthorResponse) Reset() {
-	*x = ListTopCommitAuthorResponse{}
+func (x *CommitTrailer) Reset() {
+	*x = CommitTrailer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,13 +711,13 @@ func (x *ListTopCommitAuthorResponse) Reset() {
 	}
 }
 
-func (x *ListTopCommitAuthorResponse) String() string {
+func (x *CommitTrailer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopCommitAuthorResponse) ProtoMessage() {}
+func (*CommitTrailer) ProtoMessage() {}
 
-func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
+func (x *CommitTrailer) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,32 +729,54 @@ func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopCommitAuthorResponse.ProtoReflect.Descriptor instead.
-func (*ListTopCommitAuthorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitTrailer.ProtoReflect.Descriptor instead.
+func (*CommitTrailer) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListTopCommitAuthorResponse) GetData() []*TopCommitAuthor {
+func (x *CommitTrailer) GetKey() string {
 	if x != nil {
-		return x.Data
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type MonitorRepositoryCommitsConfigParams struct {
+func (x *CommitTrailer) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CommitTrailer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommitTrailer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CommitFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerName       string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	RepoName        string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	FromDate        string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
-	ToDate          string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
-	DurationInHours int64  `protobuf:"varint,5,opt,name=durationInHours,proto3" json:"durationInHours,omitempty"`
+	Filename         string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Status           string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousFilename string `protobuf:"bytes,3,opt,name=previousFilename,proto3" json:"previousFilename,omitempty"`
+	Additions        int64  `protobuf:"varint,4,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions        int64  `protobuf:"varint,5,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Changes          int64  `protobuf:"varint,6,opt,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) Reset() {
-	*x = MonitorRepositoryCommitsConfigParams{}
+func (x *CommitFile) Reset() {
+	*x = CommitFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,13 +784,13 @@ func (x *MonitorRepositoryCommitsConfigParams) Reset() {
 	}
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) String() string {
+func (x *CommitFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorRepositoryCommitsConfigParams) ProtoMessage() {}
+func (*CommitFile) ProtoMessage() {}
 
-func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Message {
+func (x *CommitFile) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,57 +802,63 @@ func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorRepositoryCommitsConfigParams.ProtoReflect.Descriptor instead.
-func (*MonitorRepositoryCommitsConfigParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitFile.ProtoReflect.Descriptor instead.
+func (*CommitFile) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetOwnerName() string {
+func (x *CommitFile) GetFilename() string {
 	if x != nil {
-		return x.OwnerName
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetRepoName() string {
+func (x *CommitFile) GetStatus() string {
 	if x != nil {
-		return x.RepoName
+		return x.Status
 	}
 	return ""
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetFromDate() string {
+func (x *CommitFile) GetPreviousFilename() string {
 	if x != nil {
-		return x.FromDate
+		return x.PreviousFilename
 	}
 	return ""
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetToDate() string {
+func (x *CommitFile) GetAdditions() int64 {
 	if x != nil {
-		return x.ToDate
+		return x.Additions
 	}
-	return ""
+	return 0
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetDurationInHours() int64 {
+func (x *CommitFile) GetDeletions() int64 {
 	if x != nil {
-		return x.DurationInHours
+		return x.Deletions
 	}
 	return 0
 }
 
-type StopMonitoringRepositoryCommitParams struct {
+func (x *CommitFile) GetChanges() int64 {
+	if x != nil {
+		return x.Changes
+	}
+	return 0
+}
+
+type ListCommitFilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Data []*CommitFile `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *StopMonitoringRepositoryCommitParams) Reset() {
-	*x = StopMonitoringRepositoryCommitParams{}
+func (x *ListCommitFilesResponse) Reset() {
+	*x = ListCommitFilesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,207 +866,3574 @@ func (x *StopMonitoringRepositoryCommitParams) Reset() {
 	}
 }
 
-func (x *StopMonitoringRepositoryCommitParams) String() string {
+func (x *ListCommitFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopMonitoringRepositoryCommitParams) ProtoMessage() {}
+func (*ListCommitFilesResponse) ProtoMessage() {}
 
-func (x *StopMonitoringRepositoryCommitParams) ProtoReflect() protoreflect.Message {
+func (x *ListCommitFilesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StopMonitoringRepositoryCommitParams.ProtoReflect.Descriptor instead.
-func (*StopMonitoringRepositoryCommitParams) Descriptor() ([]byte, []int) {
-	return file_commits_commits_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *StopMonitoringRepositoryCommitParams) GetOwnerName() string {
-	if x != nil {
-		return x.OwnerName
-	}
-	return ""
-}
-
-func (x *StopMonitoringRepositoryCommitParams) GetRepoName() string {
-	if x != nil {
-		return x.RepoName
-	}
-	return ""
-}
-
-var File_commits_commits_proto protoreflect.FileDescriptor
-
-var file_commits_commits_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
-	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x67, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42,
-	0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22, 0x29,
-	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xbe, 0x01, 0x0a, 0x24, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x22, 0x60, 0x0a, 0x24, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x32, 0x94, 0x04, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x42, 0x65, 0x61, 0x6d,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64,
-	0x53, 0x48, 0x41, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68,
-	0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x24,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1f, 0x53, 0x74, 0x6f, 0x70,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e,
-	0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_commits_commits_proto_rawDescOnce sync.Once
-	file_commits_commits_proto_rawDescData = file_commits_commits_proto_rawDesc
-)
-
-func file_commits_commits_proto_rawDescGZIP() []byte {
-	file_commits_commits_proto_rawDescOnce.Do(func() {
-		file_commits_commits_proto_rawDescData = protoimpl.X.CompressGZIP(file_commits_commits_proto_rawDescData)
-	})
-	return file_commits_commits_proto_rawDescData
-}
-
-var file_commits_commits_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_commits_commits_proto_goTypes = []interface{}{
-	(*Void)(nil),                                 // 0: commits.Void
-	(*Commit)(nil),                               // 1: commits.Commit
-	(*TopCommitAuthor)(nil),                      // 2: commits.TopCommitAuthor
-	(*CommitFilterParams)(nil),                   // 3: commits.CommitFilterParams
-	(*CommitByOwnerAndShaParams)(nil),            // 4: commits.CommitByOwnerAndShaParams
-	(*HealthCheckResponse)(nil),                  // 5: commits.HealthCheckResponse
-	(*ListCommitResponse)(nil),                   // 6: commits.ListCommitResponse
-	(*ListTopCommitAuthorResponse)(nil),          // 7: commits.ListTopCommitAuthorResponse
-	(*MonitorRepositoryCommitsConfigParams)(nil), // 8: commits.MonitorRepositoryCommitsConfigParams
-	(*StopMonitoringRepositoryCommitParams)(nil), // 9: commits.StopMonitoringRepositoryCommitParams
-}
-var file_commits_commits_proto_depIdxs = []int32{
-	1, // 0: commits.ListCommitResponse.data:type_name -> commits.Commit
-	2, // 1: commits.ListTopCommitAuthorResponse.data:type_name -> commits.TopCommitAuthor
-	3, // 2: commits.GitBeamCommitsService.ListCommits:input_type -> commits.CommitFilterParams
-	4, // 3: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:input_type -> commits.CommitByOwnerAndShaParams
-	3, // 4: commits.GitBeamCommitsService.ListTopCommitAuthor:input_type -> commits.CommitFilterParams
-	0, // 5: commits.GitBeamCommitsService.HealthCheck:input_type -> commits.Void
-	8, // 6: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:input_type -> commits.MonitorRepositoryCommitsConfigParams
-	9, // 7: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:input_type -> commits.StopMonitoringRepositoryCommitParams
-	6, // 8: commits.GitBeamCommitsService.ListCommits:output_type -> commits.ListCommitResponse
-	1, // 9: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:output_type -> commits.Commit
-	7, // 10: commits.GitBeamCommitsService.ListTopCommitAuthor:output_type -> commits.ListTopCommitAuthorResponse
-	5, // 11: commits.GitBeamCommitsService.HealthCheck:output_type -> commits.HealthCheckResponse
-	0, // 12: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:output_type -> commits.Void
-	0, // 13: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:output_type -> commits.Void
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
-}
-
-func init() { file_commits_commits_proto_init() }
-func file_commits_commits_proto_init() {
-	if File_commits_commits_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_commits_commits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Void); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommitFilesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCommitFilesResponse) GetData() []*CommitFile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TopCommitAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author          string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	CommitsCount    int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+	CoAuthoredCount int64  `protobuf:"varint,3,opt,name=coAuthoredCount,proto3" json:"coAuthoredCount,omitempty"`
+}
+
+func (x *TopCommitAuthor) Reset() {
+	*x = TopCommitAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopCommitAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopCommitAuthor) ProtoMessage() {}
+
+func (x *TopCommitAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopCommitAuthor.ProtoReflect.Descriptor instead.
+func (*TopCommitAuthor) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TopCommitAuthor) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *TopCommitAuthor) GetCommitsCount() int64 {
+	if x != nil {
+		return x.CommitsCount
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetCoAuthoredCount() int64 {
+	if x != nil {
+		return x.CoAuthoredCount
+	}
+	return 0
+}
+
+type CommitFilterParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit            int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OwnerName        string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName         string `protobuf:"bytes,4,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	FromDate         string `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate           string `protobuf:"bytes,6,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	Branch           string `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	Author           string `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	Committer        string `protobuf:"bytes,9,opt,name=committer,proto3" json:"committer,omitempty"`
+	GroupBy          string `protobuf:"bytes,10,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	IncludeCoAuthors bool   `protobuf:"varint,11,opt,name=includeCoAuthors,proto3" json:"includeCoAuthors,omitempty"`
+	Type             string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	Scope            string `protobuf:"bytes,13,opt,name=scope,proto3" json:"scope,omitempty"`
+	BreakingOnly     bool   `protobuf:"varint,14,opt,name=breakingOnly,proto3" json:"breakingOnly,omitempty"`
+	Verification     string `protobuf:"bytes,15,opt,name=verification,proto3" json:"verification,omitempty"`
+	Host             string `protobuf:"bytes,16,opt,name=host,proto3" json:"host,omitempty"`
+	CiConclusion     string `protobuf:"bytes,17,opt,name=ciConclusion,proto3" json:"ciConclusion,omitempty"`
+}
+
+func (x *CommitFilterParams) Reset() {
+	*x = CommitFilterParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitFilterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitFilterParams) ProtoMessage() {}
+
+func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitFilterParams.ProtoReflect.Descriptor instead.
+func (*CommitFilterParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CommitFilterParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CommitFilterParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CommitFilterParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetIncludeCoAuthors() bool {
+	if x != nil {
+		return x.IncludeCoAuthors
+	}
+	return false
+}
+
+func (x *CommitFilterParams) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetBreakingOnly() bool {
+	if x != nil {
+		return x.BreakingOnly
+	}
+	return false
+}
+
+func (x *CommitFilterParams) GetVerification() string {
+	if x != nil {
+		return x.Verification
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetCiConclusion() string {
+	if x != nil {
+		return x.CiConclusion
+	}
+	return ""
+}
+
+type CommitByOwnerAndShaParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Sha       string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	Host      string `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *CommitByOwnerAndShaParams) Reset() {
+	*x = CommitByOwnerAndShaParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitByOwnerAndShaParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitByOwnerAndShaParams) ProtoMessage() {}
+
+func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitByOwnerAndShaParams.ProtoReflect.Descriptor instead.
+func (*CommitByOwnerAndShaParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommitByOwnerAndShaParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitByOwnerAndShaParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitByOwnerAndShaParams) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *CommitByOwnerAndShaParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type SourceHostHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host                string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Provider            string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	State               string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ConsecutiveFailures int64  `protobuf:"varint,4,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	LastError           string `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	ChangedAt           string `protobuf:"bytes,6,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
+}
+
+func (x *SourceHostHealth) Reset() {
+	*x = SourceHostHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceHostHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceHostHealth) ProtoMessage() {}
+
+func (x *SourceHostHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceHostHealth.ProtoReflect.Descriptor instead.
+func (*SourceHostHealth) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SourceHostHealth) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SourceHostHealth) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SourceHostHealth) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SourceHostHealth) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *SourceHostHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SourceHostHealth) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int64               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Sources []*SourceHostHealth `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HealthCheckResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetSources() []*SourceHostHealth {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ListCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Commit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListCommitResponse) Reset() {
+	*x = ListCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitResponse) ProtoMessage() {}
+
+func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitResponse.ProtoReflect.Descriptor instead.
+func (*ListCommitResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCommitResponse) GetData() []*Commit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListTopCommitAuthorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*TopCommitAuthor `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListTopCommitAuthorResponse) Reset() {
+	*x = ListTopCommitAuthorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopCommitAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopCommitAuthorResponse) ProtoMessage() {}
+
+func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopCommitAuthorResponse.ProtoReflect.Descriptor instead.
+func (*ListTopCommitAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTopCommitAuthorResponse) GetData() []*TopCommitAuthor {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MonitorRepositoryCommitsConfigParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName       string   `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName        string   `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	FromDate        string   `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate          string   `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	DurationInHours int64    `protobuf:"varint,5,opt,name=durationInHours,proto3" json:"durationInHours,omitempty"`
+	Branches        []string `protobuf:"bytes,6,rep,name=branches,proto3" json:"branches,omitempty"`
+	Host            string   `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
+	Provider        string   `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	WebhookSecret   string   `protobuf:"bytes,9,opt,name=webhookSecret,proto3" json:"webhookSecret,omitempty"`
+	Fetcher         string   `protobuf:"bytes,10,opt,name=fetcher,proto3" json:"fetcher,omitempty"`
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) Reset() {
+	*x = MonitorRepositoryCommitsConfigParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorRepositoryCommitsConfigParams) ProtoMessage() {}
+
+func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorRepositoryCommitsConfigParams.ProtoReflect.Descriptor instead.
+func (*MonitorRepositoryCommitsConfigParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetDurationInHours() int64 {
+	if x != nil {
+		return x.DurationInHours
+	}
+	return 0
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetFetcher() string {
+	if x != nil {
+		return x.Fetcher
+	}
+	return ""
+}
+
+type StopMonitoringRepositoryCommitParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *StopMonitoringRepositoryCommitParams) Reset() {
+	*x = StopMonitoringRepositoryCommitParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopMonitoringRepositoryCommitParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMonitoringRepositoryCommitParams) ProtoMessage() {}
+
+func (x *StopMonitoringRepositoryCommitParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMonitoringRepositoryCommitParams.ProtoReflect.Descriptor instead.
+func (*StopMonitoringRepositoryCommitParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type RepositoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *RepositoryParams) Reset() {
+	*x = RepositoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryParams) ProtoMessage() {}
+
+func (x *RepositoryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryParams.ProtoReflect.Descriptor instead.
+func (*RepositoryParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RepositoryParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepositoryParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RepositoryParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type SyncCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branch       string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	HeadSha      string `protobuf:"bytes,2,opt,name=headSha,proto3" json:"headSha,omitempty"`
+	NewCommits   int64  `protobuf:"varint,3,opt,name=newCommits,proto3" json:"newCommits,omitempty"`
+	LastSyncedAt string `protobuf:"bytes,4,opt,name=lastSyncedAt,proto3" json:"lastSyncedAt,omitempty"`
+}
+
+func (x *SyncCursor) Reset() {
+	*x = SyncCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCursor) ProtoMessage() {}
+
+func (x *SyncCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCursor.ProtoReflect.Descriptor instead.
+func (*SyncCursor) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SyncCursor) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *SyncCursor) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *SyncCursor) GetNewCommits() int64 {
+	if x != nil {
+		return x.NewCommits
+	}
+	return 0
+}
+
+func (x *SyncCursor) GetLastSyncedAt() string {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return ""
+}
+
+type BackfillCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Since          string `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until          string `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Status         string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	NextPage       int64  `protobuf:"varint,4,opt,name=nextPage,proto3" json:"nextPage,omitempty"`
+	CommitsWritten int64  `protobuf:"varint,5,opt,name=commitsWritten,proto3" json:"commitsWritten,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Branch         string `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *BackfillCheckpoint) Reset() {
+	*x = BackfillCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackfillCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillCheckpoint) ProtoMessage() {}
+
+func (x *BackfillCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillCheckpoint.ProtoReflect.Descriptor instead.
+func (*BackfillCheckpoint) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BackfillCheckpoint) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *BackfillCheckpoint) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *BackfillCheckpoint) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BackfillCheckpoint) GetNextPage() int64 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+func (x *BackfillCheckpoint) GetCommitsWritten() int64 {
+	if x != nil {
+		return x.CommitsWritten
+	}
+	return 0
+}
+
+func (x *BackfillCheckpoint) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *BackfillCheckpoint) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type SyncStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data          []*SyncCursor         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Backfills     []*BackfillCheckpoint `protobuf:"bytes,2,rep,name=backfills,proto3" json:"backfills,omitempty"`
+	MonitorStatus string                `protobuf:"bytes,3,opt,name=monitorStatus,proto3" json:"monitorStatus,omitempty"`
+	LastError     string                `protobuf:"bytes,4,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastErrorAt   string                `protobuf:"bytes,5,opt,name=lastErrorAt,proto3" json:"lastErrorAt,omitempty"`
+}
+
+func (x *SyncStatusResponse) Reset() {
+	*x = SyncStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatusResponse) ProtoMessage() {}
+
+func (x *SyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SyncStatusResponse) GetData() []*SyncCursor {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SyncStatusResponse) GetBackfills() []*BackfillCheckpoint {
+	if x != nil {
+		return x.Backfills
+	}
+	return nil
+}
+
+func (x *SyncStatusResponse) GetMonitorStatus() string {
+	if x != nil {
+		return x.MonitorStatus
+	}
+	return ""
+}
+
+func (x *SyncStatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SyncStatusResponse) GetLastErrorAt() string {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return ""
+}
+
+type AuthorAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AliasName      string `protobuf:"bytes,1,opt,name=aliasName,proto3" json:"aliasName,omitempty"`
+	AliasEmail     string `protobuf:"bytes,2,opt,name=aliasEmail,proto3" json:"aliasEmail,omitempty"`
+	CanonicalName  string `protobuf:"bytes,3,opt,name=canonicalName,proto3" json:"canonicalName,omitempty"`
+	CanonicalEmail string `protobuf:"bytes,4,opt,name=canonicalEmail,proto3" json:"canonicalEmail,omitempty"`
+}
+
+func (x *AuthorAlias) Reset() {
+	*x = AuthorAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorAlias) ProtoMessage() {}
+
+func (x *AuthorAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorAlias.ProtoReflect.Descriptor instead.
+func (*AuthorAlias) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuthorAlias) GetAliasName() string {
+	if x != nil {
+		return x.AliasName
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetAliasEmail() string {
+	if x != nil {
+		return x.AliasEmail
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetCanonicalName() string {
+	if x != nil {
+		return x.CanonicalName
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetCanonicalEmail() string {
+	if x != nil {
+		return x.CanonicalEmail
+	}
+	return ""
+}
+
+type ListAuthorAliasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*AuthorAlias `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListAuthorAliasesResponse) Reset() {
+	*x = ListAuthorAliasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthorAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorAliasesResponse) ProtoMessage() {}
+
+func (x *ListAuthorAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuthorAliasesResponse) GetData() []*AuthorAlias {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportMailmapParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ImportMailmapParams) Reset() {
+	*x = ImportMailmapParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportMailmapParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMailmapParams) ProtoMessage() {}
+
+func (x *ImportMailmapParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMailmapParams.ProtoReflect.Descriptor instead.
+func (*ImportMailmapParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportMailmapParams) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportMailmapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+}
+
+func (x *ImportMailmapResponse) Reset() {
+	*x = ImportMailmapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportMailmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMailmapResponse) ProtoMessage() {}
+
+func (x *ImportMailmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMailmapResponse.ProtoReflect.Descriptor instead.
+func (*ImportMailmapResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportMailmapResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type ChangelogParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Branch    string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	FromDate  string `protobuf:"bytes,4,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,5,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	FromSha   string `protobuf:"bytes,6,opt,name=fromSha,proto3" json:"fromSha,omitempty"`
+	ToSha     string `protobuf:"bytes,7,opt,name=toSha,proto3" json:"toSha,omitempty"`
+	Host      string `protobuf:"bytes,8,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *ChangelogParams) Reset() {
+	*x = ChangelogParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangelogParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangelogParams) ProtoMessage() {}
+
+func (x *ChangelogParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangelogParams.ProtoReflect.Descriptor instead.
+func (*ChangelogParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChangelogParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetFromSha() string {
+	if x != nil {
+		return x.FromSha
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetToSha() string {
+	if x != nil {
+		return x.ToSha
+	}
+	return ""
+}
+
+func (x *ChangelogParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ChangelogSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title   string    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Commits []*Commit `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`
+}
+
+func (x *ChangelogSection) Reset() {
+	*x = ChangelogSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangelogSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangelogSection) ProtoMessage() {}
+
+func (x *ChangelogSection) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangelogSection.ProtoReflect.Descriptor instead.
+func (*ChangelogSection) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChangelogSection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChangelogSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChangelogSection) GetCommits() []*Commit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+type ChangelogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ChangelogSection `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ChangelogResponse) Reset() {
+	*x = ChangelogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangelogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangelogResponse) ProtoMessage() {}
+
+func (x *ChangelogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangelogResponse.ProtoReflect.Descriptor instead.
+func (*ChangelogResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangelogResponse) GetData() []*ChangelogSection {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type VerificationReasonCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Count  int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *VerificationReasonCount) Reset() {
+	*x = VerificationReasonCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerificationReasonCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationReasonCount) ProtoMessage() {}
+
+func (x *VerificationReasonCount) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationReasonCount.ProtoReflect.Descriptor instead.
+func (*VerificationReasonCount) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VerificationReasonCount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VerificationReasonCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SignatureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName               string                     `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName                string                     `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	TotalCommits            int64                      `protobuf:"varint,3,opt,name=totalCommits,proto3" json:"totalCommits,omitempty"`
+	VerifiedCommits         int64                      `protobuf:"varint,4,opt,name=verifiedCommits,proto3" json:"verifiedCommits,omitempty"`
+	UnsignedCommits         int64                      `protobuf:"varint,5,opt,name=unsignedCommits,proto3" json:"unsignedCommits,omitempty"`
+	UnverifiedSignedCommits int64                      `protobuf:"varint,6,opt,name=unverifiedSignedCommits,proto3" json:"unverifiedSignedCommits,omitempty"`
+	UnknownCommits          int64                      `protobuf:"varint,7,opt,name=unknownCommits,proto3" json:"unknownCommits,omitempty"`
+	SignedRatio             float64                    `protobuf:"fixed64,8,opt,name=signedRatio,proto3" json:"signedRatio,omitempty"`
+	Reasons                 []*VerificationReasonCount `protobuf:"bytes,9,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *SignatureReport) Reset() {
+	*x = SignatureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureReport) ProtoMessage() {}
+
+func (x *SignatureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignatureReport.ProtoReflect.Descriptor instead.
+func (*SignatureReport) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SignatureReport) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *SignatureReport) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *SignatureReport) GetTotalCommits() int64 {
+	if x != nil {
+		return x.TotalCommits
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetVerifiedCommits() int64 {
+	if x != nil {
+		return x.VerifiedCommits
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetUnsignedCommits() int64 {
+	if x != nil {
+		return x.UnsignedCommits
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetUnverifiedSignedCommits() int64 {
+	if x != nil {
+		return x.UnverifiedSignedCommits
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetUnknownCommits() int64 {
+	if x != nil {
+		return x.UnknownCommits
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetSignedRatio() float64 {
+	if x != nil {
+		return x.SignedRatio
+	}
+	return 0
+}
+
+func (x *SignatureReport) GetReasons() []*VerificationReasonCount {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type HistoryRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerName    string   `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName     string   `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Branch       string   `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	OldHeadSha   string   `protobuf:"bytes,5,opt,name=oldHeadSha,proto3" json:"oldHeadSha,omitempty"`
+	NewHeadSha   string   `protobuf:"bytes,6,opt,name=newHeadSha,proto3" json:"newHeadSha,omitempty"`
+	OrphanedShas []string `protobuf:"bytes,7,rep,name=orphanedShas,proto3" json:"orphanedShas,omitempty"`
+	DetectedAt   string   `protobuf:"bytes,8,opt,name=detectedAt,proto3" json:"detectedAt,omitempty"`
+	Host         string   `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *HistoryRewrite) Reset() {
+	*x = HistoryRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRewrite) ProtoMessage() {}
+
+func (x *HistoryRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRewrite.ProtoReflect.Descriptor instead.
+func (*HistoryRewrite) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HistoryRewrite) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HistoryRewrite) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetOldHeadSha() string {
+	if x != nil {
+		return x.OldHeadSha
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetNewHeadSha() string {
+	if x != nil {
+		return x.NewHeadSha
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetOrphanedShas() []string {
+	if x != nil {
+		return x.OrphanedShas
+	}
+	return nil
+}
+
+func (x *HistoryRewrite) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+func (x *HistoryRewrite) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ListHistoryRewritesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*HistoryRewrite `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListHistoryRewritesResponse) Reset() {
+	*x = ListHistoryRewritesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHistoryRewritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryRewritesResponse) ProtoMessage() {}
+
+func (x *ListHistoryRewritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryRewritesResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryRewritesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListHistoryRewritesResponse) GetData() []*HistoryRewrite {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Repository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host          string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	OwnerName     string   `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName      string   `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	DefaultBranch string   `protobuf:"bytes,4,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
+	Visibility    string   `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Description   string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Topics        []string `protobuf:"bytes,7,rep,name=topics,proto3" json:"topics,omitempty"`
+	Stars         int64    `protobuf:"varint,8,opt,name=stars,proto3" json:"stars,omitempty"`
+	Archived      bool     `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty"`
+	PushedAt      string   `protobuf:"bytes,10,opt,name=pushedAt,proto3" json:"pushedAt,omitempty"`
+	SyncedAt      string   `protobuf:"bytes,11,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	DeletedAt     string   `protobuf:"bytes,12,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	SourceId      int64    `protobuf:"varint,13,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Repository) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Repository) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *Repository) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *Repository) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *Repository) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Repository) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Repository) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Repository) GetStars() int64 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *Repository) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Repository) GetPushedAt() string {
+	if x != nil {
+		return x.PushedAt
+	}
+	return ""
+}
+
+func (x *Repository) GetSyncedAt() string {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return ""
+}
+
+func (x *Repository) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Repository) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+type ListRepositoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Repository `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListRepositoriesResponse) Reset() {
+	*x = ListRepositoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepositoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepositoriesResponse) ProtoMessage() {}
+
+func (x *ListRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRepositoriesResponse) GetData() []*Repository {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Release struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host        string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	OwnerName   string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName    string `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	TagName     string `protobuf:"bytes,4,opt,name=tagName,proto3" json:"tagName,omitempty"`
+	Sha         string `protobuf:"bytes,5,opt,name=sha,proto3" json:"sha,omitempty"`
+	Name        string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Body        string `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Url         string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Author      string `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
+	Draft       bool   `protobuf:"varint,10,opt,name=draft,proto3" json:"draft,omitempty"`
+	Prerelease  bool   `protobuf:"varint,11,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	PublishedAt string `protobuf:"bytes,12,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Release) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Release) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *Release) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *Release) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *Release) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *Release) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Release) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Release) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Release) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Release) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *Release) GetPrerelease() bool {
+	if x != nil {
+		return x.Prerelease
+	}
+	return false
+}
+
+func (x *Release) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+type ListReleasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Release `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListReleasesResponse) Reset() {
+	*x = ListReleasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesResponse) ProtoMessage() {}
+
+func (x *ListReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListReleasesResponse) GetData() []*Release {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommitStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context     string `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	State       string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TargetUrl   string `protobuf:"bytes,4,opt,name=targetUrl,proto3" json:"targetUrl,omitempty"`
+	UpdatedAt   string `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *CommitStatus) Reset() {
+	*x = CommitStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStatus) ProtoMessage() {}
+
+func (x *CommitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStatus.ProtoReflect.Descriptor instead.
+func (*CommitStatus) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CommitStatus) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *CommitStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CommitStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CommitStatus) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *CommitStatus) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CheckRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status      string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Conclusion  string `protobuf:"bytes,3,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	DetailsUrl  string `protobuf:"bytes,4,opt,name=detailsUrl,proto3" json:"detailsUrl,omitempty"`
+	StartedAt   string `protobuf:"bytes,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	CompletedAt string `protobuf:"bytes,6,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+}
+
+func (x *CheckRun) Reset() {
+	*x = CheckRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRun) ProtoMessage() {}
+
+func (x *CheckRun) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRun.ProtoReflect.Descriptor instead.
+func (*CheckRun) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CheckRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CheckRun) GetConclusion() string {
+	if x != nil {
+		return x.Conclusion
+	}
+	return ""
+}
+
+func (x *CheckRun) GetDetailsUrl() string {
+	if x != nil {
+		return x.DetailsUrl
+	}
+	return ""
+}
+
+func (x *CheckRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *CheckRun) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type CommitChecks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host       string          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	OwnerName  string          `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName   string          `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Sha        string          `protobuf:"bytes,4,opt,name=sha,proto3" json:"sha,omitempty"`
+	State      string          `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Conclusion string          `protobuf:"bytes,6,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	Statuses   []*CommitStatus `protobuf:"bytes,7,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	CheckRuns  []*CheckRun     `protobuf:"bytes,8,rep,name=checkRuns,proto3" json:"checkRuns,omitempty"`
+	UpdatedAt  string          `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *CommitChecks) Reset() {
+	*x = CommitChecks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitChecks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitChecks) ProtoMessage() {}
+
+func (x *CommitChecks) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitChecks.ProtoReflect.Descriptor instead.
+func (*CommitChecks) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CommitChecks) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetConclusion() string {
+	if x != nil {
+		return x.Conclusion
+	}
+	return ""
+}
+
+func (x *CommitChecks) GetStatuses() []*CommitStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CommitChecks) GetCheckRuns() []*CheckRun {
+	if x != nil {
+		return x.CheckRuns
+	}
+	return nil
+}
+
+func (x *CommitChecks) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CommitsBetweenParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	FromRef   string `protobuf:"bytes,4,opt,name=fromRef,proto3" json:"fromRef,omitempty"`
+	ToRef     string `protobuf:"bytes,5,opt,name=toRef,proto3" json:"toRef,omitempty"`
+}
+
+func (x *CommitsBetweenParams) Reset() {
+	*x = CommitsBetweenParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitsBetweenParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitsBetweenParams) ProtoMessage() {}
+
+func (x *CommitsBetweenParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitsBetweenParams.ProtoReflect.Descriptor instead.
+func (*CommitsBetweenParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CommitsBetweenParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitsBetweenParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitsBetweenParams) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CommitsBetweenParams) GetFromRef() string {
+	if x != nil {
+		return x.FromRef
+	}
+	return ""
+}
+
+func (x *CommitsBetweenParams) GetToRef() string {
+	if x != nil {
+		return x.ToRef
+	}
+	return ""
+}
+
+var File_commits_commits_proto protoreflect.FileDescriptor
+
+var file_commits_commits_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0xbc, 0x07, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c,
+	0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x1b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x50, 0x75, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x79, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x12, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x61, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc2, 0x01, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x22, 0x42, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x77, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63,
+	0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xec,
+	0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x43, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x69, 0x43,
+	0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x69, 0x43, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a,
+	0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e,
+	0x64, 0x53, 0x68, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x10, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x33,
+	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xca, 0x02, 0x0a, 0x24,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x24, 0x53, 0x74, 0x6f, 0x70,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x60,
+	0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x22, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x53,
+	0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x53, 0x68,
+	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0xde, 0x01, 0x0a, 0x12, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x45, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x33, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x6f, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x53, 0x68,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x22, 0x67, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x6f, 0x67, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x47, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x03, 0x0a, 0x0f, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x38,
+	0x0a, 0x17, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x17, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x8a,
+	0x02, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x53, 0x68,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x53, 0x68, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x53, 0x68,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64,
+	0x53, 0x68, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x53,
+	0x68, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x65, 0x64, 0x53, 0x68, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfe, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x02,
+	0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3c, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x75, 0x6e, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x01, 0x0a,
+	0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x52, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x52, 0x65, 0x66, 0x32, 0x98, 0x0d, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x42, 0x65, 0x61, 0x6d, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53,
+	0x48, 0x41, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x24, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1f, 0x53, 0x74, 0x6f, 0x70, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0d, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x6d,
+	0x61, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x22,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x1a, 0x0d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x50, 0x75,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x42, 0x0b,
+	0x5a, 0x09, 0x2e, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_commits_commits_proto_rawDescOnce sync.Once
+	file_commits_commits_proto_rawDescData = file_commits_commits_proto_rawDesc
+)
+
+func file_commits_commits_proto_rawDescGZIP() []byte {
+	file_commits_commits_proto_rawDescOnce.Do(func() {
+		file_commits_commits_proto_rawDescData = protoimpl.X.CompressGZIP(file_commits_commits_proto_rawDescData)
+	})
+	return file_commits_commits_proto_rawDescData
+}
+
+var file_commits_commits_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_commits_commits_proto_goTypes = []interface{}{
+	(*Void)(nil),                                 // 0: commits.Void
+	(*RepositoryRef)(nil),                        // 1: commits.RepositoryRef
+	(*Commit)(nil),                               // 2: commits.Commit
+	(*PullRequest)(nil),                          // 3: commits.PullRequest
+	(*PullRequestParams)(nil),                    // 4: commits.PullRequestParams
+	(*CommitVerification)(nil),                   // 5: commits.CommitVerification
+	(*ConventionalCommit)(nil),                   // 6: commits.ConventionalCommit
+	(*CommitTrailer)(nil),                        // 7: commits.CommitTrailer
+	(*CommitFile)(nil),                           // 8: commits.CommitFile
+	(*ListCommitFilesResponse)(nil),              // 9: commits.ListCommitFilesResponse
+	(*TopCommitAuthor)(nil),                      // 10: commits.TopCommitAuthor
+	(*CommitFilterParams)(nil),                   // 11: commits.CommitFilterParams
+	(*CommitByOwnerAndShaParams)(nil),            // 12: commits.CommitByOwnerAndShaParams
+	(*SourceHostHealth)(nil),                     // 13: commits.SourceHostHealth
+	(*HealthCheckResponse)(nil),                  // 14: commits.HealthCheckResponse
+	(*ListCommitResponse)(nil),                   // 15: commits.ListCommitResponse
+	(*ListTopCommitAuthorResponse)(nil),          // 16: commits.ListTopCommitAuthorResponse
+	(*MonitorRepositoryCommitsConfigParams)(nil), // 17: commits.MonitorRepositoryCommitsConfigParams
+	(*StopMonitoringRepositoryCommitParams)(nil), // 18: commits.StopMonitoringRepositoryCommitParams
+	(*RepositoryParams)(nil),                     // 19: commits.RepositoryParams
+	(*SyncCursor)(nil),                           // 20: commits.SyncCursor
+	(*BackfillCheckpoint)(nil),                   // 21: commits.BackfillCheckpoint
+	(*SyncStatusResponse)(nil),                   // 22: commits.SyncStatusResponse
+	(*AuthorAlias)(nil),                          // 23: commits.AuthorAlias
+	(*ListAuthorAliasesResponse)(nil),            // 24: commits.ListAuthorAliasesResponse
+	(*ImportMailmapParams)(nil),                  // 25: commits.ImportMailmapParams
+	(*ImportMailmapResponse)(nil),                // 26: commits.ImportMailmapResponse
+	(*ChangelogParams)(nil),                      // 27: commits.ChangelogParams
+	(*ChangelogSection)(nil),                     // 28: commits.ChangelogSection
+	(*ChangelogResponse)(nil),                    // 29: commits.ChangelogResponse
+	(*VerificationReasonCount)(nil),              // 30: commits.VerificationReasonCount
+	(*SignatureReport)(nil),                      // 31: commits.SignatureReport
+	(*HistoryRewrite)(nil),                       // 32: commits.HistoryRewrite
+	(*ListHistoryRewritesResponse)(nil),          // 33: commits.ListHistoryRewritesResponse
+	(*Repository)(nil),                           // 34: commits.Repository
+	(*ListRepositoriesResponse)(nil),             // 35: commits.ListRepositoriesResponse
+	(*Release)(nil),                              // 36: commits.Release
+	(*ListReleasesResponse)(nil),                 // 37: commits.ListReleasesResponse
+	(*CommitStatus)(nil),                         // 38: commits.CommitStatus
+	(*CheckRun)(nil),                             // 39: commits.CheckRun
+	(*CommitChecks)(nil),                         // 40: commits.CommitChecks
+	(*CommitsBetweenParams)(nil),                 // 41: commits.CommitsBetweenParams
+}
+var file_commits_commits_proto_depIdxs = []int32{
+	1,  // 0: commits.Commit.repositories:type_name -> commits.RepositoryRef
+	7,  // 1: commits.Commit.trailers:type_name -> commits.CommitTrailer
+	6,  // 2: commits.Commit.conventional:type_name -> commits.ConventionalCommit
+	5,  // 3: commits.Commit.verification:type_name -> commits.CommitVerification
+	3,  // 4: commits.Commit.pullRequests:type_name -> commits.PullRequest
+	8,  // 5: commits.ListCommitFilesResponse.data:type_name -> commits.CommitFile
+	13, // 6: commits.HealthCheckResponse.sources:type_name -> commits.SourceHostHealth
+	2,  // 7: commits.ListCommitResponse.data:type_name -> commits.Commit
+	10, // 8: commits.ListTopCommitAuthorResponse.data:type_name -> commits.TopCommitAuthor
+	20, // 9: commits.SyncStatusResponse.data:type_name -> commits.SyncCursor
+	21, // 10: commits.SyncStatusResponse.backfills:type_name -> commits.BackfillCheckpoint
+	23, // 11: commits.ListAuthorAliasesResponse.data:type_name -> commits.AuthorAlias
+	2,  // 12: commits.ChangelogSection.commits:type_name -> commits.Commit
+	28, // 13: commits.ChangelogResponse.data:type_name -> commits.ChangelogSection
+	30, // 14: commits.SignatureReport.reasons:type_name -> commits.VerificationReasonCount
+	32, // 15: commits.ListHistoryRewritesResponse.data:type_name -> commits.HistoryRewrite
+	34, // 16: commits.ListRepositoriesResponse.data:type_name -> commits.Repository
+	36, // 17: commits.ListReleasesResponse.data:type_name -> commits.Release
+	38, // 18: commits.CommitChecks.statuses:type_name -> commits.CommitStatus
+	39, // 19: commits.CommitChecks.checkRuns:type_name -> commits.CheckRun
+	11, // 20: commits.GitBeamCommitsService.ListCommits:input_type -> commits.CommitFilterParams
+	12, // 21: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:input_type -> commits.CommitByOwnerAndShaParams
+	11, // 22: commits.GitBeamCommitsService.ListTopCommitAuthor:input_type -> commits.CommitFilterParams
+	0,  // 23: commits.GitBeamCommitsService.HealthCheck:input_type -> commits.Void
+	17, // 24: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:input_type -> commits.MonitorRepositoryCommitsConfigParams
+	18, // 25: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:input_type -> commits.StopMonitoringRepositoryCommitParams
+	19, // 26: commits.GitBeamCommitsService.GetRepositorySyncStatus:input_type -> commits.RepositoryParams
+	12, // 27: commits.GitBeamCommitsService.GetCommitFiles:input_type -> commits.CommitByOwnerAndShaParams
+	25, // 28: commits.GitBeamCommitsService.ImportMailmap:input_type -> commits.ImportMailmapParams
+	0,  // 29: commits.GitBeamCommitsService.ListAuthorAliases:input_type -> commits.Void
+	23, // 30: commits.GitBeamCommitsService.SaveAuthorAlias:input_type -> commits.AuthorAlias
+	23, // 31: commits.GitBeamCommitsService.DeleteAuthorAlias:input_type -> commits.AuthorAlias
+	27, // 32: commits.GitBeamCommitsService.GetChangelog:input_type -> commits.ChangelogParams
+	11, // 33: commits.GitBeamCommitsService.GetSignatureReport:input_type -> commits.CommitFilterParams
+	19, // 34: commits.GitBeamCommitsService.ListHistoryRewrites:input_type -> commits.RepositoryParams
+	19, // 35: commits.GitBeamCommitsService.GetRepository:input_type -> commits.RepositoryParams
+	0,  // 36: commits.GitBeamCommitsService.ListRepositories:input_type -> commits.Void
+	4,  // 37: commits.GitBeamCommitsService.ListCommitsForPullRequest:input_type -> commits.PullRequestParams
+	19, // 38: commits.GitBeamCommitsService.ListReleases:input_type -> commits.RepositoryParams
+	41, // 39: commits.GitBeamCommitsService.ListCommitsBetween:input_type -> commits.CommitsBetweenParams
+	12, // 40: commits.GitBeamCommitsService.GetCommitChecks:input_type -> commits.CommitByOwnerAndShaParams
+	15, // 41: commits.GitBeamCommitsService.ListCommits:output_type -> commits.ListCommitResponse
+	2,  // 42: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:output_type -> commits.Commit
+	16, // 43: commits.GitBeamCommitsService.ListTopCommitAuthor:output_type -> commits.ListTopCommitAuthorResponse
+	14, // 44: commits.GitBeamCommitsService.HealthCheck:output_type -> commits.HealthCheckResponse
+	0,  // 45: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:output_type -> commits.Void
+	0,  // 46: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:output_type -> commits.Void
+	22, // 47: commits.GitBeamCommitsService.GetRepositorySyncStatus:output_type -> commits.SyncStatusResponse
+	9,  // 48: commits.GitBeamCommitsService.GetCommitFiles:output_type -> commits.ListCommitFilesResponse
+	26, // 49: commits.GitBeamCommitsService.ImportMailmap:output_type -> commits.ImportMailmapResponse
+	24, // 50: commits.GitBeamCommitsService.ListAuthorAliases:output_type -> commits.ListAuthorAliasesResponse
+	0,  // 51: commits.GitBeamCommitsService.SaveAuthorAlias:output_type -> commits.Void
+	0,  // 52: commits.GitBeamCommitsService.DeleteAuthorAlias:output_type -> commits.Void
+	29, // 53: commits.GitBeamCommitsService.GetChangelog:output_type -> commits.ChangelogResponse
+	31, // 54: commits.GitBeamCommitsService.GetSignatureReport:output_type -> commits.SignatureReport
+	33, // 55: commits.GitBeamCommitsService.ListHistoryRewrites:output_type -> commits.ListHistoryRewritesResponse
+	34, // 56: commits.GitBeamCommitsService.GetRepository:output_type -> commits.Repository
+	35, // 57: commits.GitBeamCommitsService.ListRepositories:output_type -> commits.ListRepositoriesResponse
+	15, // 58: commits.GitBeamCommitsService.ListCommitsForPullRequest:output_type -> commits.ListCommitResponse
+	37, // 59: commits.GitBeamCommitsService.ListReleases:output_type -> commits.ListReleasesResponse
+	15, // 60: commits.GitBeamCommitsService.ListCommitsBetween:output_type -> commits.ListCommitResponse
+	40, // 61: commits.GitBeamCommitsService.GetCommitChecks:output_type -> commits.CommitChecks
+	41, // [41:62] is the sub-list for method output_type
+	20, // [20:41] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_commits_commits_proto_init() }
+func file_commits_commits_proto_init() {
+	if File_commits_commits_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_commits_commits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Void); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequestParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConventionalCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopCommitAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitFilterParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitByOwnerAndShaParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceHostHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopCommitAuthorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorRepositoryCommitsConfigParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMonitoringRepositoryCommitParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackfillCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuthorAliasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMailmapParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMailmapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -828,8 +4444,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
+		file_commits_commits_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangelogParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -840,8 +4456,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopCommitAuthor); i {
+		file_commits_commits_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangelogSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -852,8 +4468,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitFilterParams); i {
+		file_commits_commits_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangelogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -864,8 +4480,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitByOwnerAndShaParams); i {
+		file_commits_commits_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationReasonCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -876,8 +4492,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckResponse); i {
+		file_commits_commits_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignatureReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -888,8 +4504,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitResponse); i {
+		file_commits_commits_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryRewrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -900,8 +4516,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTopCommitAuthorResponse); i {
+		file_commits_commits_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHistoryRewritesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -912,8 +4528,8 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorRepositoryCommitsConfigParams); i {
+		file_commits_commits_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -924,8 +4540,80 @@ func file_commits_commits_proto_init() {
 				return nil
 			}
 		}
-		file_commits_commits_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMonitoringRepositoryCommitParams); i {
+		file_commits_commits_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Release); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitChecks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitsBetweenParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -943,7 +4631,7 @@ func file_commits_commits_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commits_commits_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -975,6 +4663,21 @@ type GitBeamCommitsServiceClient interface {
 	HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error)
 	StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error)
+	GetRepositorySyncStatus(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*SyncStatusResponse, error)
+	GetCommitFiles(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*ListCommitFilesResponse, error)
+	ImportMailmap(ctx context.Context, in *ImportMailmapParams, opts ...grpc.CallOption) (*ImportMailmapResponse, error)
+	ListAuthorAliases(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListAuthorAliasesResponse, error)
+	SaveAuthorAlias(ctx context.Context, in *AuthorAlias, opts ...grpc.CallOption) (*Void, error)
+	DeleteAuthorAlias(ctx context.Context, in *AuthorAlias, opts ...grpc.CallOption) (*Void, error)
+	GetChangelog(ctx context.Context, in *ChangelogParams, opts ...grpc.CallOption) (*ChangelogResponse, error)
+	GetSignatureReport(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*SignatureReport, error)
+	ListHistoryRewrites(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*ListHistoryRewritesResponse, error)
+	GetRepository(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*Repository, error)
+	ListRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListRepositoriesResponse, error)
+	ListCommitsForPullRequest(ctx context.Context, in *PullRequestParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	ListReleases(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*ListReleasesResponse, error)
+	ListCommitsBetween(ctx context.Context, in *CommitsBetweenParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	GetCommitChecks(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*CommitChecks, error)
 }
 
 type gitBeamCommitsServiceClient struct {
@@ -1012,27 +4715,162 @@ func (c *gitBeamCommitsServiceClient) ListTopCommitAuthor(ctx context.Context, i
 	return out, nil
 }
 
-func (c *gitBeamCommitsServiceClient) HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/HealthCheck", in, out, opts...)
+func (c *gitBeamCommitsServiceClient) HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetRepositorySyncStatus(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetRepositorySyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetCommitFiles(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*ListCommitFilesResponse, error) {
+	out := new(ListCommitFilesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetCommitFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ImportMailmap(ctx context.Context, in *ImportMailmapParams, opts ...grpc.CallOption) (*ImportMailmapResponse, error) {
+	out := new(ImportMailmapResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ImportMailmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListAuthorAliases(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListAuthorAliasesResponse, error) {
+	out := new(ListAuthorAliasesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListAuthorAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) SaveAuthorAlias(ctx context.Context, in *AuthorAlias, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/SaveAuthorAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) DeleteAuthorAlias(ctx context.Context, in *AuthorAlias, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/DeleteAuthorAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetChangelog(ctx context.Context, in *ChangelogParams, opts ...grpc.CallOption) (*ChangelogResponse, error) {
+	out := new(ChangelogResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetSignatureReport(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*SignatureReport, error) {
+	out := new(SignatureReport)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetSignatureReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListHistoryRewrites(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*ListHistoryRewritesResponse, error) {
+	out := new(ListHistoryRewritesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListHistoryRewrites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetRepository(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*Repository, error) {
+	out := new(Repository)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListRepositoriesResponse, error) {
+	out := new(ListRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListCommitsForPullRequest(ctx context.Context, in *PullRequestParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommitsForPullRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListReleases(ctx context.Context, in *RepositoryParams, opts ...grpc.CallOption) (*ListReleasesResponse, error) {
+	out := new(ListReleasesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListReleases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits", in, out, opts...)
+func (c *gitBeamCommitsServiceClient) ListCommitsBetween(ctx context.Context, in *CommitsBetweenParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommitsBetween", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits", in, out, opts...)
+func (c *gitBeamCommitsServiceClient) GetCommitChecks(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*CommitChecks, error) {
+	out := new(CommitChecks)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetCommitChecks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1047,6 +4885,21 @@ type GitBeamCommitsServiceServer interface {
 	HealthCheck(context.Context, *Void) (*HealthCheckResponse, error)
 	StartMonitoringRepositoryCommits(context.Context, *MonitorRepositoryCommitsConfigParams) (*Void, error)
 	StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error)
+	GetRepositorySyncStatus(context.Context, *RepositoryParams) (*SyncStatusResponse, error)
+	GetCommitFiles(context.Context, *CommitByOwnerAndShaParams) (*ListCommitFilesResponse, error)
+	ImportMailmap(context.Context, *ImportMailmapParams) (*ImportMailmapResponse, error)
+	ListAuthorAliases(context.Context, *Void) (*ListAuthorAliasesResponse, error)
+	SaveAuthorAlias(context.Context, *AuthorAlias) (*Void, error)
+	DeleteAuthorAlias(context.Context, *AuthorAlias) (*Void, error)
+	GetChangelog(context.Context, *ChangelogParams) (*ChangelogResponse, error)
+	GetSignatureReport(context.Context, *CommitFilterParams) (*SignatureReport, error)
+	ListHistoryRewrites(context.Context, *RepositoryParams) (*ListHistoryRewritesResponse, error)
+	GetRepository(context.Context, *RepositoryParams) (*Repository, error)
+	ListRepositories(context.Context, *Void) (*ListRepositoriesResponse, error)
+	ListCommitsForPullRequest(context.Context, *PullRequestParams) (*ListCommitResponse, error)
+	ListReleases(context.Context, *RepositoryParams) (*ListReleasesResponse, error)
+	ListCommitsBetween(context.Context, *CommitsBetweenParams) (*ListCommitResponse, error)
+	GetCommitChecks(context.Context, *CommitByOwnerAndShaParams) (*CommitChecks, error)
 }
 
 // UnimplementedGitBeamCommitsServiceServer can be embedded to have forward compatible implementations.
@@ -1071,6 +4924,51 @@ func (*UnimplementedGitBeamCommitsServiceServer) StartMonitoringRepositoryCommit
 func (*UnimplementedGitBeamCommitsServiceServer) StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopMonitoringRepositoryCommits not implemented")
 }
+func (*UnimplementedGitBeamCommitsServiceServer) GetRepositorySyncStatus(context.Context, *RepositoryParams) (*SyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositorySyncStatus not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetCommitFiles(context.Context, *CommitByOwnerAndShaParams) (*ListCommitFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitFiles not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ImportMailmap(context.Context, *ImportMailmapParams) (*ImportMailmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMailmap not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListAuthorAliases(context.Context, *Void) (*ListAuthorAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorAliases not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) SaveAuthorAlias(context.Context, *AuthorAlias) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAuthorAlias not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) DeleteAuthorAlias(context.Context, *AuthorAlias) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthorAlias not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetChangelog(context.Context, *ChangelogParams) (*ChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangelog not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetSignatureReport(context.Context, *CommitFilterParams) (*SignatureReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignatureReport not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListHistoryRewrites(context.Context, *RepositoryParams) (*ListHistoryRewritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryRewrites not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetRepository(context.Context, *RepositoryParams) (*Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepository not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListRepositories(context.Context, *Void) (*ListRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommitsForPullRequest(context.Context, *PullRequestParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitsForPullRequest not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListReleases(context.Context, *RepositoryParams) (*ListReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommitsBetween(context.Context, *CommitsBetweenParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitsBetween not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetCommitChecks(context.Context, *CommitByOwnerAndShaParams) (*CommitChecks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitChecks not implemented")
+}
 
 func RegisterGitBeamCommitsServiceServer(s *grpc.Server, srv GitBeamCommitsServiceServer) {
 	s.RegisterService(&_GitBeamCommitsService_serviceDesc, srv)
@@ -1184,6 +5082,276 @@ func _GitBeamCommitsService_StopMonitoringRepositoryCommits_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitBeamCommitsService_GetRepositorySyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetRepositorySyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetRepositorySyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetRepositorySyncStatus(ctx, req.(*RepositoryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetCommitFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitByOwnerAndShaParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetCommitFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetCommitFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetCommitFiles(ctx, req.(*CommitByOwnerAndShaParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ImportMailmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMailmapParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ImportMailmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ImportMailmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ImportMailmap(ctx, req.(*ImportMailmapParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListAuthorAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListAuthorAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListAuthorAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListAuthorAliases(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_SaveAuthorAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorAlias)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).SaveAuthorAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/SaveAuthorAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).SaveAuthorAlias(ctx, req.(*AuthorAlias))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_DeleteAuthorAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorAlias)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).DeleteAuthorAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/DeleteAuthorAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).DeleteAuthorAlias(ctx, req.(*AuthorAlias))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangelogParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetChangelog(ctx, req.(*ChangelogParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetSignatureReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFilterParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetSignatureReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetSignatureReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetSignatureReport(ctx, req.(*CommitFilterParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListHistoryRewrites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListHistoryRewrites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListHistoryRewrites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListHistoryRewrites(ctx, req.(*RepositoryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetRepository(ctx, req.(*RepositoryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListRepositories(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListCommitsForPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsForPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListCommitsForPullRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsForPullRequest(ctx, req.(*PullRequestParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListReleases(ctx, req.(*RepositoryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListCommitsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitsBetweenParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListCommitsBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetween(ctx, req.(*CommitsBetweenParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetCommitChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitByOwnerAndShaParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetCommitChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetCommitChecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetCommitChecks(ctx, req.(*CommitByOwnerAndShaParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GitBeamCommitsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "commits.GitBeamCommitsService",
 	HandlerType: (*GitBeamCommitsServiceServer)(nil),
@@ -1212,6 +5380,66 @@ var _GitBeamCommitsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopMonitoringRepositoryCommits",
 			Handler:    _GitBeamCommitsService_StopMonitoringRepositoryCommits_Handler,
 		},
+		{
+			MethodName: "GetRepositorySyncStatus",
+			Handler:    _GitBeamCommitsService_GetRepositorySyncStatus_Handler,
+		},
+		{
+			MethodName: "GetCommitFiles",
+			Handler:    _GitBeamCommitsService_GetCommitFiles_Handler,
+		},
+		{
+			MethodName: "ImportMailmap",
+			Handler:    _GitBeamCommitsService_ImportMailmap_Handler,
+		},
+		{
+			MethodName: "ListAuthorAliases",
+			Handler:    _GitBeamCommitsService_ListAuthorAliases_Handler,
+		},
+		{
+			MethodName: "SaveAuthorAlias",
+			Handler:    _GitBeamCommitsService_SaveAuthorAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAuthorAlias",
+			Handler:    _GitBeamCommitsService_DeleteAuthorAlias_Handler,
+		},
+		{
+			MethodName: "GetChangelog",
+			Handler:    _GitBeamCommitsService_GetChangelog_Handler,
+		},
+		{
+			MethodName: "GetSignatureReport",
+			Handler:    _GitBeamCommitsService_GetSignatureReport_Handler,
+		},
+		{
+			MethodName: "ListHistoryRewrites",
+			Handler:    _GitBeamCommitsService_ListHistoryRewrites_Handler,
+		},
+		{
+			MethodName: "GetRepository",
+			Handler:    _GitBeamCommitsService_GetRepository_Handler,
+		},
+		{
+			MethodName: "ListRepositories",
+			Handler:    _GitBeamCommitsService_ListRepositories_Handler,
+		},
+		{
+			MethodName: "ListCommitsForPullRequest",
+			Handler:    _GitBeamCommitsService_ListCommitsForPullRequest_Handler,
+		},
+		{
+			MethodName: "ListReleases",
+			Handler:    _GitBeamCommitsService_ListReleases_Handler,
+		},
+		{
+			MethodName: "ListCommitsBetween",
+			Handler:    _GitBeamCommitsService_ListCommitsBetween_Handler,
+		},
+		{
+			MethodName: "GetCommitChecks",
+			Handler:    _GitBeamCommitsService_GetCommitChecks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commits/commits.proto",
@@ -13,11 +13,46 @@ type DataStore interface {
 	GetLastCommit(ctx context.Context, owner *models.OwnerAndRepoName, startTime *time.Time) (*models.Commit, error)
 	GetCommitBySHA(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.Commit, error)
 	GetTopCommitAuthors(ctx context.Context, filter models.CommitFilters) ([]*models.TopCommitAuthor, error)
+	GetSignatureReport(ctx context.Context, filter models.CommitFilters) (*models.SignatureReport, error)
+	ListCommitsBetween(ctx context.Context, owner models.OwnerAndRepoName, fromSHA, toSHA string) ([]*models.Commit, error)
+	SaveCommitDetails(ctx context.Context, sha string, additions, deletions int, files []*models.CommitFile) error
+	HasCommitDetails(ctx context.Context, sha string) (bool, error)
+	ListCommitFiles(ctx context.Context, sha string) ([]*models.CommitFile, error)
+	SaveCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string, pullRequests []*models.PullRequest) error
+	HasCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) (bool, error)
+	ListPullRequestCommits(ctx context.Context, owner models.OwnerAndRepoName, number int) ([]*models.Commit, error)
+	SaveCommitBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) error
+	IsCommitOnBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) (bool, error)
+	GetSyncCursor(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.SyncCursor, error)
+	ListSyncCursors(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.SyncCursor, error)
+	SaveSyncCursor(ctx context.Context, cursor *models.SyncCursor) error
+	GetBackfillCheckpoint(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.BackfillCheckpoint, error)
+	ListBackfillCheckpoints(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.BackfillCheckpoint, error)
+	SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error
+	SaveHistoryRewrite(ctx context.Context, rewrite *models.HistoryRewrite) error
+	ListHistoryRewrites(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.HistoryRewrite, error)
+	SaveRepository(ctx context.Context, repo *models.Repository) error
+	GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, error)
+	ListRepositories(ctx context.Context) ([]*models.Repository, error)
+	RenameRepository(ctx context.Context, from, to models.OwnerAndRepoName) error
+	SaveCommitChecks(ctx context.Context, checks *models.CommitChecks) error
+	GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, error)
+	ListPendingCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, since time.Time, limit int) ([]string, error)
+	SaveTags(ctx context.Context, owner models.OwnerAndRepoName, tags []*models.Tag) error
+	GetTag(ctx context.Context, owner models.OwnerAndRepoName, name string) (*models.Tag, error)
+	SaveReleases(ctx context.Context, owner models.OwnerAndRepoName, releases []*models.Release) error
+	ListReleases(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.Release, error)
+	SaveAuthorAliases(ctx context.Context, aliases []*models.AuthorAlias) error
+	ListAuthorAliases(ctx context.Context) ([]*models.AuthorAlias, error)
+	DeleteAuthorAlias(ctx context.Context, aliasName, aliasEmail string) error
 }
 
 type CronServiceStore interface {
 	SaveMonitorConfigs(ctx context.Context, task models.MonitorRepositoryCommitConfig) error
 	ListMonitorConfig(ctx context.Context) ([]*models.MonitorRepositoryCommitConfig, error)
 	GetMonitorConfig(ctx context.Context, owner models.OwnerAndRepoName) (*models.MonitorRepositoryCommitConfig, error)
+	GetMonitorConfigBySourceID(ctx context.Context, host string, sourceID int64) (*models.MonitorRepositoryCommitConfig, error)
 	DeleteMonitorConfig(ctx context.Context, owner models.OwnerAndRepoName) error
+	SaveMonitorStatus(ctx context.Context, config *models.MonitorRepositoryCommitConfig) error
+	RenameMonitorConfig(ctx context.Context, from models.OwnerAndRepoName, config *models.MonitorRepositoryCommitConfig) error
 }
@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+)
+
+// Aliases apply to commits in every monitored repository, an empty alias field matches any value.
+const authorAliasesTableSetup = `
+CREATE TABLE IF NOT EXISTS author_aliases (
+		alias_name TEXT NOT NULL DEFAULT '',
+		alias_email TEXT NOT NULL DEFAULT '',
+		canonical_name TEXT NOT NULL DEFAULT '',
+		canonical_email TEXT NOT NULL DEFAULT '',
+		UNIQUE (alias_name, alias_email)
+);
+
+CREATE INDEX IF NOT EXISTS author_aliases_email ON author_aliases (alias_email);
+`
+
+// canonicalIdentity resolves the name or email (part) of the identity in nameColumn and emailColumn through
+// the most specific alias matching it: email and name over email alone, and email alone over name alone.
+// Aliases are applied at query time, so stored commits never need rewriting when they change.
+func canonicalIdentity(part, nameColumn, emailColumn string) string {
+	column := nameColumn
+	if part == "email" {
+		column = emailColumn
+	}
+
+	return fmt.Sprintf(`COALESCE((SELECT NULLIF(a.canonical_%s, '') FROM author_aliases a
+		WHERE (a.alias_email = '' OR a.alias_email = %s COLLATE NOCASE)
+		AND (a.alias_name = '' OR a.alias_name = %s COLLATE NOCASE)
+		ORDER BY a.alias_email != '' DESC, a.alias_name != '' DESC LIMIT 1), %s)`, part, emailColumn, nameColumn, column)
+}
+
+var (
+	canonicalAuthorName  = canonicalIdentity("name", "o.author", "o.author_email")
+	canonicalAuthorEmail = canonicalIdentity("email", "o.author", "o.author_email")
+)
+
+func (s sqliteRepo) SaveAuthorAliases(ctx context.Context, aliases []*models.AuthorAlias) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	upsertSQL := `
+        INSERT INTO author_aliases (
+			alias_name,
+			alias_email,
+			canonical_name,
+			canonical_email
+		)
+        VALUES (?, ?, ?, ?)
+        ON CONFLICT (alias_name, alias_email) DO UPDATE SET
+			canonical_name = excluded.canonical_name,
+			canonical_email = excluded.canonical_email`
+
+	for _, alias := range aliases {
+		if _, err = tx.ExecContext(ctx, upsertSQL,
+			alias.AliasName,
+			alias.AliasEmail,
+			alias.CanonicalName,
+			alias.CanonicalEmail,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) ListAuthorAliases(ctx context.Context) ([]*models.AuthorAlias, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT alias_name, alias_email, canonical_name, canonical_email FROM author_aliases
+		ORDER BY canonical_name, canonical_email, alias_email, alias_name`)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.AuthorAlias, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var alias models.AuthorAlias
+		if err := rows.Scan(
+			&alias.AliasName,
+			&alias.AliasEmail,
+			&alias.CanonicalName,
+			&alias.CanonicalEmail,
+		); err != nil {
+			return nil, err
+		}
+
+		list = append(list, &alias)
+	}
+
+	return list, nil
+}
+
+func (s sqliteRepo) DeleteAuthorAlias(ctx context.Context, aliasName, aliasEmail string) error {
+	_, err := s.dataStore.ExecContext(ctx,
+		`DELETE FROM author_aliases WHERE alias_name = ? AND alias_email = ?`, aliasName, aliasEmail)
+	return err
+}
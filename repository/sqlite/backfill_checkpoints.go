@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+const backfillCheckpointsTableSetup = `
+CREATE TABLE IF NOT EXISTS backfill_checkpoints (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		branch TEXT,
+		since DATETIME,
+		until DATETIME,
+		status TEXT,
+		next_page INTEGER,
+		commits_written INTEGER,
+		updated_at DATETIME,
+		next_cursor TEXT NOT NULL DEFAULT '',
+		UNIQUE (host, owner_name, repo_name, branch)
+)
+`
+
+func setupBackfillCheckpointsTable(db *sql.DB) error {
+	if err := migrateToHostKey(db, "backfill_checkpoints", backfillCheckpointsTableSetup); err != nil {
+		return err
+	}
+
+	if _, err := db.Exec(backfillCheckpointsTableSetup); err != nil {
+		return err
+	}
+
+	return addColumnIfMissing(db, "backfill_checkpoints", "next_cursor", "TEXT NOT NULL DEFAULT ''")
+}
+
+func scanBackfillCheckpoint(row rowScanner) (*models.BackfillCheckpoint, error) {
+	var checkpoint models.BackfillCheckpoint
+	var since, until, updatedAt string
+	if err := row.Scan(
+		&checkpoint.Host,
+		&checkpoint.OwnerName,
+		&checkpoint.RepoName,
+		&checkpoint.Branch,
+		&since,
+		&until,
+		&checkpoint.Status,
+		&checkpoint.NextPage,
+		&checkpoint.CommitsWritten,
+		&updatedAt,
+		&checkpoint.NextCursor,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if checkpoint.Since, err = time.Parse(time.RFC3339, since); err != nil {
+		return nil, err
+	}
+	if checkpoint.Until, err = time.Parse(time.RFC3339, until); err != nil {
+		return nil, err
+	}
+	if checkpoint.UpdatedAt, err = time.Parse(time.RFC3339, updatedAt); err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+func (s sqliteRepo) GetBackfillCheckpoint(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.BackfillCheckpoint, error) {
+	row := s.dataStore.QueryRowContext(ctx,
+		`SELECT * FROM backfill_checkpoints WHERE host = ? AND owner_name = ? AND repo_name = ? AND branch = ? LIMIT 1`,
+		owner.Host, owner.OwnerName, owner.RepoName, branch)
+	return scanBackfillCheckpoint(row)
+}
+
+func (s sqliteRepo) ListBackfillCheckpoints(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.BackfillCheckpoint, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT * FROM backfill_checkpoints WHERE host = ? AND owner_name = ? AND repo_name = ? ORDER BY branch`,
+		owner.Host, owner.OwnerName, owner.RepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*models.BackfillCheckpoint
+	defer rows.Close()
+	for rows.Next() {
+		checkpoint, err := scanBackfillCheckpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, checkpoint)
+	}
+
+	return list, nil
+}
+
+func (s sqliteRepo) SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error {
+	upsertSQL := `
+        INSERT INTO backfill_checkpoints (
+			host,
+			owner_name,
+			repo_name,
+			branch,
+			since,
+			until,
+			status,
+			next_page,
+			commits_written,
+			updated_at,
+			next_cursor
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (host, owner_name, repo_name, branch) DO UPDATE SET
+			since = excluded.since,
+			until = excluded.until,
+			status = excluded.status,
+			next_page = excluded.next_page,
+			commits_written = excluded.commits_written,
+			updated_at = excluded.updated_at,
+			next_cursor = excluded.next_cursor`
+
+	_, err := s.dataStore.ExecContext(ctx, upsertSQL,
+		checkpoint.Host,
+		checkpoint.OwnerName,
+		checkpoint.RepoName,
+		checkpoint.Branch,
+		checkpoint.Since.Format(time.RFC3339),
+		checkpoint.Until.Format(time.RFC3339),
+		checkpoint.Status,
+		checkpoint.NextPage,
+		checkpoint.CommitsWritten,
+		checkpoint.UpdatedAt.Format(time.RFC3339),
+		checkpoint.NextCursor,
+	)
+	return err
+}
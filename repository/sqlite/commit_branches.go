@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"gitbeam.commit.monitor/models"
+)
+
+const commitBranchesTableSetup = `
+CREATE TABLE IF NOT EXISTS commit_branches (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		branch TEXT,
+		sha TEXT,
+		UNIQUE (host, owner_name, repo_name, branch, sha)
+)
+`
+
+func setupCommitBranchesTable(db *sql.DB) error {
+	if err := migrateToHostKey(db, "commit_branches", commitBranchesTableSetup); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(commitBranchesTableSetup)
+	return err
+}
+
+// onBranchClause narrows a query on commitsFrom to the commits on a branch, bound as its only argument.
+const onBranchClause = `c.sha IN (
+		SELECT b.sha FROM commit_branches b
+		WHERE b.host = c.host AND b.owner_name = c.owner_name AND b.repo_name = c.repo_name AND b.branch = ?
+)`
+
+// SaveCommitBranch records that branch contains the commit, which makes it reachable again if a rewrite had orphaned it.
+func (s sqliteRepo) SaveCommitBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx,
+		`INSERT OR IGNORE INTO commit_branches (host, owner_name, repo_name, branch, sha) VALUES (?, ?, ?, ?, ?)`,
+		owner.Host, owner.OwnerName, owner.RepoName, branch, sha); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE commits SET unreachable = 0 WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ? AND unreachable = 1`,
+		owner.Host, owner.OwnerName, owner.RepoName, sha); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) IsCommitOnBranch(ctx context.Context, owner models.OwnerAndRepoName, sha, branch string) (bool, error) {
+	var count int
+	err := s.dataStore.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM commit_branches WHERE host = ? AND owner_name = ? AND repo_name = ? AND branch = ? AND sha = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, branch, sha).Scan(&count)
+	return count > 0, err
+}
+
+func (s sqliteRepo) loadCommitBranches(ctx context.Context, commit *models.Commit) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT branch FROM commit_branches WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ? ORDER BY branch`,
+		commit.Host, commit.OwnerName, commit.RepoName, commit.SHA)
+	if err != nil {
+		return err
+	}
+
+	commit.Branches = make([]string, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var branch string
+		if err := rows.Scan(&branch); err != nil {
+			return err
+		}
+
+		commit.Branches = append(commit.Branches, branch)
+	}
+
+	return rows.Err()
+}
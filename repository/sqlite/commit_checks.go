@@ -0,0 +1,225 @@
+package sqlite
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+// The checks of a commit are replaced as a whole every time they are fetched.
+const commitChecksTableSetup = `
+CREATE TABLE IF NOT EXISTS commit_checks (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		sha TEXT,
+		state TEXT,
+		conclusion TEXT,
+		updated_at DATETIME,
+		UNIQUE (host, owner_name, repo_name, sha)
+);
+
+CREATE TABLE IF NOT EXISTS commit_statuses (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		sha TEXT,
+		context TEXT,
+		state TEXT,
+		description TEXT,
+		target_url TEXT,
+		updated_at TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS commit_statuses_sha ON commit_statuses (host, owner_name, repo_name, sha);
+
+CREATE TABLE IF NOT EXISTS commit_check_runs (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		sha TEXT,
+		name TEXT,
+		status TEXT,
+		conclusion TEXT,
+		details_url TEXT,
+		started_at TEXT NOT NULL DEFAULT '',
+		completed_at TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS commit_check_runs_sha ON commit_check_runs (host, owner_name, repo_name, sha);
+`
+
+// SaveCommitChecks replaces what we knew of the checks of a commit.
+func (s sqliteRepo) SaveCommitChecks(ctx context.Context, checks *models.CommitChecks) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `
+        INSERT INTO commit_checks (host, owner_name, repo_name, sha, state, conclusion, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (host, owner_name, repo_name, sha) DO UPDATE SET
+			state = excluded.state,
+			conclusion = excluded.conclusion,
+			updated_at = excluded.updated_at`,
+		checks.Host, checks.OwnerName, checks.RepoName, checks.SHA,
+		checks.State, checks.Conclusion, checks.UpdatedAt.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	for _, table := range []string{"commit_statuses", "commit_check_runs"} {
+		if _, err = tx.ExecContext(ctx,
+			`DELETE FROM `+table+` WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ?`,
+			checks.Host, checks.OwnerName, checks.RepoName, checks.SHA); err != nil {
+			return err
+		}
+	}
+
+	for _, status := range checks.Statuses {
+		if _, err = tx.ExecContext(ctx, `
+            INSERT INTO commit_statuses (host, owner_name, repo_name, sha, context, state, description, target_url, updated_at)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			checks.Host, checks.OwnerName, checks.RepoName, checks.SHA,
+			status.Context, status.State, status.Description, status.TargetURL, formatOptionalTime(status.UpdatedAt)); err != nil {
+			return err
+		}
+	}
+
+	for _, run := range checks.CheckRuns {
+		if _, err = tx.ExecContext(ctx, `
+            INSERT INTO commit_check_runs (host, owner_name, repo_name, sha, name, status, conclusion, details_url, started_at, completed_at)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			checks.Host, checks.OwnerName, checks.RepoName, checks.SHA,
+			run.Name, run.Status, run.Conclusion, run.DetailsURL,
+			formatOptionalTime(run.StartedAt), formatOptionalTime(run.CompletedAt)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, error) {
+	checks := models.CommitChecks{
+		Statuses:  make([]*models.CommitStatus, 0),
+		CheckRuns: make([]*models.CheckRun, 0),
+	}
+
+	var updatedAt string
+	err := s.dataStore.QueryRowContext(ctx,
+		`SELECT host, owner_name, repo_name, sha, state, conclusion, updated_at
+		FROM commit_checks WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, sha).Scan(
+		&checks.Host,
+		&checks.OwnerName,
+		&checks.RepoName,
+		&checks.SHA,
+		&checks.State,
+		&checks.Conclusion,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if checks.UpdatedAt, err = time.Parse(time.RFC3339, updatedAt); err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitStatuses(ctx, &checks); err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitCheckRuns(ctx, &checks); err != nil {
+		return nil, err
+	}
+
+	return &checks, nil
+}
+
+func (s sqliteRepo) loadCommitStatuses(ctx context.Context, checks *models.CommitChecks) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT context, state, description, target_url, updated_at
+		FROM commit_statuses WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ? ORDER BY context`,
+		checks.Host, checks.OwnerName, checks.RepoName, checks.SHA)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var status models.CommitStatus
+		var updatedAt string
+		if err := rows.Scan(&status.Context, &status.State, &status.Description, &status.TargetURL, &updatedAt); err != nil {
+			return err
+		}
+
+		if status.UpdatedAt, err = parseOptionalTime(updatedAt); err != nil {
+			return err
+		}
+
+		checks.Statuses = append(checks.Statuses, &status)
+	}
+
+	return rows.Err()
+}
+
+func (s sqliteRepo) loadCommitCheckRuns(ctx context.Context, checks *models.CommitChecks) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT name, status, conclusion, details_url, started_at, completed_at
+		FROM commit_check_runs WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ? ORDER BY name`,
+		checks.Host, checks.OwnerName, checks.RepoName, checks.SHA)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var run models.CheckRun
+		var startedAt, completedAt string
+		if err := rows.Scan(&run.Name, &run.Status, &run.Conclusion, &run.DetailsURL, &startedAt, &completedAt); err != nil {
+			return err
+		}
+
+		if run.StartedAt, err = parseOptionalTime(startedAt); err != nil {
+			return err
+		}
+
+		if run.CompletedAt, err = parseOptionalTime(completedAt); err != nil {
+			return err
+		}
+
+		checks.CheckRuns = append(checks.CheckRuns, &run)
+	}
+
+	return rows.Err()
+}
+
+// ListPendingCommitChecks returns the SHAs of the commits of a repository whose checks are still running, along with
+// those committed since the given time that had no checks yet, newest commits first.
+func (s sqliteRepo) ListPendingCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, since time.Time, limit int) ([]string, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT k.sha FROM commit_checks k JOIN commit_objects o ON o.sha = k.sha
+		WHERE k.host = ? AND k.owner_name = ? AND k.repo_name = ?
+			AND (k.conclusion = ? OR k.conclusion = ? AND datetime(o.commit_date) >= datetime(?))
+		ORDER BY o.commit_date DESC LIMIT ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, models.CIConclusionPending, models.CIConclusionNone,
+		since.UTC().Format(time.RFC3339), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	shas := make([]string, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var sha string
+		if err := rows.Scan(&sha); err != nil {
+			return nil, err
+		}
+		shas = append(shas, sha)
+	}
+
+	return shas, rows.Err()
+}
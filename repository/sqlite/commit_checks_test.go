@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestListPendingCommitChecks(t *testing.T) {
+	dataStore := newTestDataStore(t)
+	ctx := context.Background()
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	now := time.Now().UTC().Truncate(time.Second)
+
+	commits := []struct {
+		sha        string
+		age        time.Duration
+		conclusion string
+	}{
+		{"pending-old", 30 * 24 * time.Hour, models.CIConclusionPending},
+		{"pending-new", time.Hour, models.CIConclusionPending},
+		{"none-new", 2 * time.Hour, models.CIConclusionNone},
+		{"none-old", 48 * time.Hour, models.CIConclusionNone},
+		{"failure-new", 3 * time.Hour, models.CIConclusionFailure},
+	}
+
+	for _, c := range commits {
+		if err := dataStore.SaveCommit(ctx, &models.Commit{
+			Date:      now.Add(-c.age).In(time.FixedZone("", 2*60*60)), // Offsets are compared as instants.
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+			SHA:       c.sha,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := dataStore.SaveCommitChecks(ctx, &models.CommitChecks{
+			UpdatedAt:  now,
+			OwnerName:  owner.OwnerName,
+			RepoName:   owner.RepoName,
+			SHA:        c.sha,
+			Conclusion: c.conclusion,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := dataStore.ListPendingCommitChecks(ctx, owner, now.Add(-24*time.Hour), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"pending-new", "none-new", "pending-old"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPendingCommitChecks() = %v, want %v", got, want)
+	}
+}
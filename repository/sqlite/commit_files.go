@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+)
+
+const commitFilesTableSetup = `
+CREATE TABLE IF NOT EXISTS commit_files (
+		sha TEXT,
+		filename TEXT,
+		status TEXT,
+		previous_filename TEXT,
+		additions INTEGER,
+		deletions INTEGER,
+		changes INTEGER,
+		UNIQUE (sha, filename)
+)
+`
+
+// SaveCommitDetails stores the line stats and changed files of a commit body, replacing any earlier copy.
+func (s sqliteRepo) SaveCommitDetails(ctx context.Context, sha string, additions, deletions int, files []*models.CommitFile) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE commit_objects SET additions = ?, deletions = ?, details_fetched = 1 WHERE sha = ?`,
+		additions, deletions, sha); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM commit_files WHERE sha = ?`, sha); err != nil {
+		return err
+	}
+
+	insertSQL := `
+        INSERT OR REPLACE INTO commit_files (
+			sha,
+			filename,
+			status,
+			previous_filename,
+			additions,
+			deletions,
+			changes
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	for _, file := range files {
+		if _, err = tx.ExecContext(ctx, insertSQL,
+			sha,
+			file.Filename,
+			file.Status,
+			file.PreviousFilename,
+			file.Additions,
+			file.Deletions,
+			file.Changes,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) HasCommitDetails(ctx context.Context, sha string) (bool, error) {
+	var fetched bool
+	err := s.dataStore.QueryRowContext(ctx,
+		`SELECT details_fetched FROM commit_objects WHERE sha = ?`, sha).Scan(&fetched)
+	return fetched, err
+}
+
+func (s sqliteRepo) ListCommitFiles(ctx context.Context, sha string) ([]*models.CommitFile, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT sha, filename, status, previous_filename, additions, deletions, changes
+		FROM commit_files WHERE sha = ? ORDER BY filename`, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.CommitFile, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var file models.CommitFile
+		if err := rows.Scan(
+			&file.SHA,
+			&file.Filename,
+			&file.Status,
+			&file.PreviousFilename,
+			&file.Additions,
+			&file.Deletions,
+			&file.Changes,
+		); err != nil {
+			return nil, err
+		}
+
+		list = append(list, &file)
+	}
+
+	return list, nil
+}
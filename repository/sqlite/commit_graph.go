@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+)
+
+// ancestorsQuery walks the stored parent graph from a SHA, bound as its only argument, yielding it and every ancestor we have.
+const ancestorsQuery = `SELECT ? UNION
+		SELECT p.value FROM %s a JOIN commit_objects g ON g.sha = a.sha, json_each(g.parent_commit_ids) p`
+
+// ListCommitsBetween returns the commits of a repository reachable from toSHA but not from fromSHA, newest first,
+// like git log fromSHA..toSHA. An empty fromSHA returns all of toSHA's stored history.
+func (s sqliteRepo) ListCommitsBetween(ctx context.Context, owner models.OwnerAndRepoName, fromSHA, toSHA string) ([]*models.Commit, error) {
+	query := fmt.Sprintf(`WITH RECURSIVE
+		included(sha) AS (%s),
+		excluded(sha) AS (%s)
+		SELECT %s FROM %s
+		WHERE c.host = ? AND c.owner_name = ? AND c.repo_name = ? AND c.sha IN (SELECT sha FROM included EXCEPT SELECT sha FROM excluded)
+		ORDER BY o.commit_date DESC`,
+		fmt.Sprintf(ancestorsQuery, "included"), fmt.Sprintf(ancestorsQuery, "excluded"), commitColumns, commitsFrom)
+
+	return s.queryCommits(ctx, query, toSHA, fromSHA, owner.Host, owner.OwnerName, owner.RepoName)
+}
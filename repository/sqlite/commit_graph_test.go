@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestListCommitsBetween(t *testing.T) {
+	dataStore := newTestDataStore(t)
+	ctx := context.Background()
+	owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+
+	// a - b - c - m - d on main, with f branching off a and merged by m.
+	graph := []struct {
+		sha     string
+		parents []string
+	}{
+		{"a", nil},
+		{"b", []string{"a"}},
+		{"f", []string{"a"}},
+		{"c", []string{"b"}},
+		{"m", []string{"c", "f"}},
+		{"d", []string{"m"}},
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, node := range graph {
+		if err := dataStore.SaveCommit(ctx, &models.Commit{
+			Date:            start.Add(time.Duration(i) * time.Hour),
+			Host:            owner.Host,
+			OwnerName:       owner.OwnerName,
+			RepoName:        owner.RepoName,
+			SHA:             node.sha,
+			ParentCommitIDs: node.parents,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A fork sharing history is stored under its own name.
+	fork := models.OwnerAndRepoName{Host: "ghe", OwnerName: "fork", RepoName: "r"}
+	if err := dataStore.SaveCommit(ctx, &models.Commit{
+		Date: start.Add(time.Hour), Host: fork.Host, OwnerName: fork.OwnerName, RepoName: fork.RepoName, SHA: "a",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		owner    models.OwnerAndRepoName
+		from, to string
+		want     string
+	}{
+		{"whole history", owner, "", "d", "[d m c f b a]"},
+		{"since a release", owner, "c", "d", "[d m f]"},
+		{"merged branch", owner, "f", "m", "[m c b]"},
+		{"side of a merge", owner, "c", "f", "[f]"},
+		{"same commit", owner, "d", "d", "[]"},
+		{"from a descendant", owner, "d", "b", "[]"},
+		{"unknown to", owner, "", "zz", "[]"},
+		{"unknown from", owner, "zz", "c", "[c b a]"},
+		{"other repository", fork, "", "d", "[a]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commits, err := dataStore.ListCommitsBetween(ctx, tt.owner, tt.from, tt.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shas := make([]string, 0, len(commits))
+			for _, commit := range commits {
+				shas = append(shas, commit.SHA)
+			}
+			if got := fmt.Sprint(shas); got != tt.want {
+				t.Errorf("ListCommitsBetween(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
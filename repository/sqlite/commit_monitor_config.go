@@ -3,9 +3,11 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"gitbeam.commit.monitor/models"
 	"gitbeam.commit.monitor/repository"
 	_ "github.com/mattn/go-sqlite3"
+	"time"
 )
 
 const cronTrackerTableSetup = `
@@ -15,12 +17,50 @@ CREATE TABLE IF NOT EXISTS cron_tasks (
 		from_date DATETIME,
 		to_date DATETIME,
 		duration_in_hours INTEGER,
-		UNIQUE (repo_name, owner_name)
+		branches TEXT NOT NULL DEFAULT '[]',
+		host TEXT NOT NULL DEFAULT '',
+		provider TEXT NOT NULL DEFAULT 'github',
+		webhook_secret TEXT NOT NULL DEFAULT '',
+		fetcher TEXT NOT NULL DEFAULT 'rest',
+		status TEXT NOT NULL DEFAULT 'pending',
+		last_error TEXT NOT NULL DEFAULT '',
+		last_error_at TEXT NOT NULL DEFAULT '',
+		source_id INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (host, repo_name, owner_name)
 )
 `
 
+func setupCronTrackerTable(db *sql.DB) error {
+	if err := migrateToHostKey(db, "cron_tasks", cronTrackerTableSetup); err != nil {
+		return err
+	}
+
+	if _, err := db.Exec(cronTrackerTableSetup); err != nil {
+		return err
+	}
+
+	columns := []struct{ name, definition string }{
+		{"provider", "TEXT NOT NULL DEFAULT 'github'"},
+		{"webhook_secret", "TEXT NOT NULL DEFAULT ''"},
+		{"fetcher", "TEXT NOT NULL DEFAULT 'rest'"},
+		{"status", "TEXT NOT NULL DEFAULT 'pending'"},
+		{"last_error", "TEXT NOT NULL DEFAULT ''"},
+		{"last_error_at", "TEXT NOT NULL DEFAULT ''"},
+		{"source_id", "INTEGER NOT NULL DEFAULT 0"},
+	}
+
+	for _, column := range columns {
+		if err := addColumnIfMissing(db, "cron_tasks", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func scanCronTrackerRow(row *sql.Row) (*models.MonitorRepositoryCommitConfig, error) {
 	var cronTracker models.MonitorRepositoryCommitConfig
+	var serializedBranches, lastErrorAt string
 	var err error
 	if err = row.Scan(
 		&cronTracker.RepoName,
@@ -28,15 +68,37 @@ func scanCronTrackerRow(row *sql.Row) (*models.MonitorRepositoryCommitConfig, er
 		&cronTracker.FromDate,
 		&cronTracker.ToDate,
 		&cronTracker.DurationInHours,
+		&serializedBranches,
+		&cronTracker.Host,
+		&cronTracker.Provider,
+		&cronTracker.WebhookSecret,
+		&cronTracker.Fetcher,
+		&cronTracker.Status,
+		&cronTracker.LastError,
+		&lastErrorAt,
+		&cronTracker.SourceID,
 	); err != nil {
 		return nil, err
 	}
 
+	if err = json.Unmarshal([]byte(serializedBranches), &cronTracker.Branches); err != nil {
+		return nil, err
+	}
+
+	if lastErrorAt != "" {
+		at, err := time.Parse(time.RFC3339, lastErrorAt)
+		if err != nil {
+			return nil, err
+		}
+		cronTracker.LastErrorAt = &at
+	}
+
 	return &cronTracker, nil
 }
 
 func scanCronTrackerRows(rows *sql.Rows) (*models.MonitorRepositoryCommitConfig, error) {
 	var cronTracker models.MonitorRepositoryCommitConfig
+	var serializedBranches, lastErrorAt string
 	var err error
 	if err = rows.Scan(
 		&cronTracker.RepoName,
@@ -44,10 +106,31 @@ func scanCronTrackerRows(rows *sql.Rows) (*models.MonitorRepositoryCommitConfig,
 		&cronTracker.FromDate,
 		&cronTracker.ToDate,
 		&cronTracker.DurationInHours,
+		&serializedBranches,
+		&cronTracker.Host,
+		&cronTracker.Provider,
+		&cronTracker.WebhookSecret,
+		&cronTracker.Fetcher,
+		&cronTracker.Status,
+		&cronTracker.LastError,
+		&lastErrorAt,
+		&cronTracker.SourceID,
 	); err != nil {
 		return nil, err
 	}
 
+	if err = json.Unmarshal([]byte(serializedBranches), &cronTracker.Branches); err != nil {
+		return nil, err
+	}
+
+	if lastErrorAt != "" {
+		at, err := time.Parse(time.RFC3339, lastErrorAt)
+		if err != nil {
+			return nil, err
+		}
+		cronTracker.LastErrorAt = &at
+	}
+
 	return &cronTracker, nil
 }
 
@@ -80,29 +163,89 @@ func (s sqliteRepo) SaveMonitorConfigs(ctx context.Context, payload models.Monit
 			owner_name,
 			from_date,
 			to_date,
-			duration_in_hours
+			duration_in_hours,
+			branches,
+			host,
+			provider,
+			webhook_secret,
+			fetcher,
+			status,
+			source_id
 		)
-        VALUES (?, ?, ?, ?, ?)`
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	if payload.Branches == nil {
+		payload.Branches = make([]string, 0)
+	}
 
-	_, err := s.dataStore.ExecContext(ctx, insertSQL,
+	if payload.Fetcher == "" {
+		payload.Fetcher = models.FetcherREST
+	}
+
+	if payload.Status == "" {
+		payload.Status = models.MonitorPending
+	}
+
+	serializedBranches, err := json.Marshal(payload.Branches)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.dataStore.ExecContext(ctx, insertSQL,
 		payload.RepoName,
 		payload.OwnerName,
 		payload.FromDate,
 		payload.ToDate,
 		payload.DurationInHours,
+		string(serializedBranches),
+		payload.Host,
+		payload.Provider,
+		payload.WebhookSecret,
+		payload.Fetcher,
+		payload.Status,
+		payload.SourceID,
 	)
 	return err
 }
 
+// SaveMonitorStatus stores the Status, LastError and LastErrorAt of config.
+func (s sqliteRepo) SaveMonitorStatus(ctx context.Context, config *models.MonitorRepositoryCommitConfig) error {
+	var lastErrorAt string
+	if config.LastErrorAt != nil {
+		lastErrorAt = config.LastErrorAt.Format(time.RFC3339)
+	}
+
+	_, err := s.dataStore.ExecContext(ctx,
+		`UPDATE cron_tasks SET status = ?, last_error = ?, last_error_at = ? WHERE host = ? AND owner_name = ? AND repo_name = ?`,
+		config.Status, config.LastError, lastErrorAt, config.Host, config.OwnerName, config.RepoName)
+	return err
+}
+
+// RenameMonitorConfig moves the monitor of the repository from to the name and source ID of config.
+func (s sqliteRepo) RenameMonitorConfig(ctx context.Context, from models.OwnerAndRepoName, config *models.MonitorRepositoryCommitConfig) error {
+	_, err := s.dataStore.ExecContext(ctx,
+		`UPDATE cron_tasks SET host = ?, owner_name = ?, repo_name = ?, source_id = ? WHERE host = ? AND owner_name = ? AND repo_name = ?`,
+		config.Host, config.OwnerName, config.RepoName, config.SourceID, from.Host, from.OwnerName, from.RepoName)
+	return err
+}
+
 func (s sqliteRepo) GetMonitorConfig(ctx context.Context, owner models.OwnerAndRepoName) (*models.MonitorRepositoryCommitConfig, error) {
 	row := s.dataStore.QueryRowContext(ctx,
-		`SELECT * from cron_tasks WHERE owner_name = ? AND repo_name = ? LIMIT 1`, owner.OwnerName, owner.RepoName)
+		`SELECT * from cron_tasks WHERE host = ? AND owner_name = ? AND repo_name = ? LIMIT 1`, owner.Host, owner.OwnerName, owner.RepoName)
+	return scanCronTrackerRow(row)
+}
+
+// GetMonitorConfigBySourceID returns the monitor of the repository of host with the given source ID, whatever it
+// was named when last synced.
+func (s sqliteRepo) GetMonitorConfigBySourceID(ctx context.Context, host string, sourceID int64) (*models.MonitorRepositoryCommitConfig, error) {
+	row := s.dataStore.QueryRowContext(ctx,
+		`SELECT * from cron_tasks WHERE host = ? AND source_id = ? AND source_id != 0 LIMIT 1`, host, sourceID)
 	return scanCronTrackerRow(row)
 }
 
 func (s sqliteRepo) DeleteMonitorConfig(ctx context.Context, owner models.OwnerAndRepoName) error {
 	_, err := s.dataStore.ExecContext(ctx,
-		`DELETE from cron_tasks WHERE owner_name = ? AND repo_name = ?`, owner.OwnerName, owner.RepoName)
+		`DELETE from cron_tasks WHERE host = ? AND owner_name = ? AND repo_name = ?`, owner.Host, owner.OwnerName, owner.RepoName)
 
 	return err
 }
@@ -112,7 +255,7 @@ func NewSqliteCronStore(dbName string) (repository.CronServiceStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	if _, err := db.Exec(cronTrackerTableSetup); err != nil {
+	if err := setupCronTrackerTable(db); err != nil {
 		return nil, err
 	}
 	return &sqliteRepo{
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"gitbeam.commit.monitor/models"
+)
+
+// Trailers are part of the commit message, so like it they are stored once per commit body.
+const commitTrailersTableSetup = `
+CREATE TABLE IF NOT EXISTS commit_trailers (
+		sha TEXT,
+		position INTEGER,
+		key TEXT,
+		value TEXT,
+		name TEXT,
+		email TEXT,
+		UNIQUE (sha, position)
+);
+
+CREATE INDEX IF NOT EXISTS commit_trailers_key ON commit_trailers (key COLLATE NOCASE);
+`
+
+// setupCommitTrailersTable creates the trailers table. The trailers of commits stored before it existed are
+// parsed in the background, see reparseCommitMessages.
+func setupCommitTrailersTable(db *sql.DB) error {
+	if _, err := db.Exec(commitTrailersTableSetup); err != nil {
+		return err
+	}
+
+	return addColumnIfMissing(db, "commit_objects", "trailers_parsed", "INTEGER NOT NULL DEFAULT 0")
+}
+
+// saveCommitTrailers stores the trailers of a commit body and marks it as parsed.
+func saveCommitTrailers(ctx context.Context, tx *sql.Tx, sha string, trailers []*models.CommitTrailer) error {
+	insertSQL := `
+        INSERT OR IGNORE INTO commit_trailers (
+			sha,
+			position,
+			key,
+			value,
+			name,
+			email
+		)
+        VALUES (?, ?, ?, ?, ?, ?)`
+
+	for position, trailer := range trailers {
+		if _, err := tx.ExecContext(ctx, insertSQL,
+			sha,
+			position,
+			trailer.Key,
+			trailer.Value,
+			trailer.Name,
+			trailer.Email,
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `UPDATE commit_objects SET trailers_parsed = 1 WHERE sha = ?`, sha)
+	return err
+}
+
+func (s sqliteRepo) loadCommitTrailers(ctx context.Context, commit *models.Commit) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT key, value, name, email FROM commit_trailers WHERE sha = ? ORDER BY position`, commit.SHA)
+	if err != nil {
+		return err
+	}
+
+	commit.Trailers = make([]*models.CommitTrailer, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var trailer models.CommitTrailer
+		if err := rows.Scan(&trailer.Key, &trailer.Value, &trailer.Name, &trailer.Email); err != nil {
+			return err
+		}
+
+		commit.Trailers = append(commit.Trailers, &trailer)
+	}
+
+	return rows.Err()
+}
@@ -9,127 +9,302 @@ import (
 	"time"
 )
 
+// Commit bodies are shared by every repository that contains them (e.g. a fork and its upstream),
+// while the commits table records which repositories contain which SHA.
 const commitsTableSetup = `
-CREATE TABLE IF NOT EXISTS commits (
+CREATE TABLE IF NOT EXISTS commit_objects (
     	sha TEXT PRIMARY KEY,
 		message TEXT,
 		author TEXT,
-		repo_name TEXT,
+		parent_commit_ids TEXT,
+		commit_date DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS commits (
+		host TEXT NOT NULL DEFAULT '',
 		owner_name TEXT,
+		repo_name TEXT,
+		sha TEXT,
 		url TEXT,
-		parent_commit_ids TEXT,
-		commit_date DATETIME,
-		UNIQUE (repo_name, owner_name, sha)
-)
+		unreachable INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (host, owner_name, repo_name, sha)
+);
+
+CREATE INDEX IF NOT EXISTS commits_sha ON commits (sha);
 `
 
-func deserializeParentCommitIds(data string) ([]string, error) {
-	var ids []string
-	err := json.Unmarshal([]byte(data), &ids)
-	if err != nil {
-		return nil, err
+// commitColumns and commitsFrom select a repository's commits along with their shared bodies, in scanCommit order.
+const commitColumns = `c.sha, o.message, o.author, c.host, c.repo_name, c.owner_name, c.url, o.parent_commit_ids, o.commit_date,
+		o.additions, o.deletions, o.author_email, o.author_date, o.author_login, o.author_id,
+		o.committer_name, o.committer_email, o.committer_login, o.committer_id,
+		o.cc_type, o.cc_scope, o.cc_subject, o.cc_breaking, o.verified, o.verification_reason, o.signature,
+		c.unreachable`
+const commitsFrom = `commits c JOIN commit_objects o ON o.sha = c.sha`
+
+// authorMatchClause matches the author by recorded or canonical name and email, or GitHub login, taking the value five times.
+var authorMatchClause = fmt.Sprintf(`(o.author = ? COLLATE NOCASE OR o.author_email = ? COLLATE NOCASE OR o.author_login = ? COLLATE NOCASE
+		OR %s = ? COLLATE NOCASE OR %s = ? COLLATE NOCASE)`, canonicalAuthorName, canonicalAuthorEmail)
+
+// committerMatchClause matches the committer by name, email or GitHub login, taking the value three times.
+const committerMatchClause = `(o.committer_name = ? COLLATE NOCASE OR o.committer_email = ? COLLATE NOCASE OR o.committer_login = ? COLLATE NOCASE)`
+
+// authorGroupByColumns maps models.AuthorGroupBy values to the column of commitIdentities top commit authors are grouped by.
+var authorGroupByColumns = map[string]string{
+	models.AuthorGroupByName:  "name",
+	models.AuthorGroupByEmail: "email",
+	models.AuthorGroupByLogin: "login",
+}
+
+// commitIdentities selects the canonical author identity of the commits matching a commitFilterClause.
+var commitIdentities = fmt.Sprintf(`SELECT c.sha, %s AS name, %s AS email, o.author_login AS login, 0 AS co_authored
+		FROM %s WHERE %%s`, canonicalAuthorName, canonicalAuthorEmail, commitsFrom)
+
+// coAuthorIdentities selects the canonical identities credited by Co-authored-by trailers on the commits matching a commitFilterClause.
+var coAuthorIdentities = fmt.Sprintf(`SELECT c.sha, %s AS name, %s AS email, '' AS login, 1 AS co_authored
+		FROM %s JOIN commit_trailers t ON t.sha = c.sha AND t.key = '%s' COLLATE NOCASE WHERE %%s`,
+	canonicalIdentity("name", "t.name", "t.email"), canonicalIdentity("email", "t.name", "t.email"), commitsFrom, models.TrailerCoAuthoredBy)
+
+func setupCommitsTables(db *sql.DB) error {
+	if err := migrateLegacyCommitsTable(db); err != nil {
+		return err
 	}
-	return ids, nil
+
+	if err := migrateToHostKey(db, "commits", commitsTableSetup); err != nil {
+		return err
+	}
+
+	if _, err := db.Exec(commitsTableSetup); err != nil {
+		return err
+	}
+
+	columns := []struct{ name, definition string }{
+		{"additions", "INTEGER NOT NULL DEFAULT 0"},
+		{"deletions", "INTEGER NOT NULL DEFAULT 0"},
+		{"details_fetched", "INTEGER NOT NULL DEFAULT 0"},
+		{"author_email", "TEXT NOT NULL DEFAULT ''"},
+		{"author_date", "TEXT NOT NULL DEFAULT ''"},
+		{"author_login", "TEXT NOT NULL DEFAULT ''"},
+		{"author_id", "INTEGER NOT NULL DEFAULT 0"},
+		{"committer_name", "TEXT NOT NULL DEFAULT ''"},
+		{"committer_email", "TEXT NOT NULL DEFAULT ''"},
+		{"committer_login", "TEXT NOT NULL DEFAULT ''"},
+		{"committer_id", "INTEGER NOT NULL DEFAULT 0"},
+		{"verified", "INTEGER NOT NULL DEFAULT 0"},
+		{"verification_reason", "TEXT NOT NULL DEFAULT ''"},
+		{"signature", "TEXT NOT NULL DEFAULT ''"},
+	}
+
+	for _, column := range columns {
+		if err := addColumnIfMissing(db, "commit_objects", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func scanCommitRows(rows *sql.Rows) (*models.Commit, error) {
-	var serializedParentCommitIDs string
-	var dateString string
-	var commit models.Commit
-	var err error
-	if err = rows.Scan(
-		&commit.SHA,
-		&commit.Message,
-		&commit.Author,
-		&commit.RepoName,
-		&commit.OwnerName,
-		&commit.URL,
-		&serializedParentCommitIDs,
-		&dateString,
-	); err != nil {
-		return nil, err
+// migrateLegacyCommitsTable splits the commits table of older versions, which was keyed by SHA alone,
+// into commit_objects and per repository commits.
+func migrateLegacyCommitsTable(db *sql.DB) error {
+	isLegacy, err := hasColumn(db, "commits", "message")
+	if err != nil || !isLegacy {
+		return err
 	}
 
-	commit.Date, err = time.Parse(time.RFC3339, dateString)
+	tx, err := db.Begin()
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		`ALTER TABLE commits RENAME TO commits_legacy`,
+		commitsTableSetup,
+		`INSERT OR IGNORE INTO commit_objects (sha, message, author, parent_commit_ids, commit_date)
+			SELECT sha, message, author, parent_commit_ids, commit_date FROM commits_legacy`,
+		`INSERT OR IGNORE INTO commits (owner_name, repo_name, sha, url)
+			SELECT owner_name, repo_name, sha, url FROM commits_legacy`,
+		`DROP TABLE commits_legacy`,
 	}
 
-	commit.ParentCommitIDs, err = deserializeParentCommitIds(serializedParentCommitIDs)
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func deserializeParentCommitIds(data string) ([]string, error) {
+	var ids []string
+	err := json.Unmarshal([]byte(data), &ids)
 	if err != nil {
 		return nil, err
 	}
-
-	return &commit, nil
+	return ids, nil
 }
 
-func scanCommitRow(row *sql.Row) (*models.Commit, error) {
+func scanCommit(row rowScanner) (*models.Commit, error) {
 	var serializedParentCommitIDs string
 	var dateString string
+	var authorDateString string
+	var conventional models.ConventionalCommit
+	var verification models.CommitVerification
 	var commit models.Commit
 	var err error
 	if err = row.Scan(
 		&commit.SHA,
 		&commit.Message,
 		&commit.Author,
+		&commit.Host,
 		&commit.RepoName,
 		&commit.OwnerName,
 		&commit.URL,
 		&serializedParentCommitIDs,
 		&dateString,
+		&commit.Additions,
+		&commit.Deletions,
+		&commit.AuthorEmail,
+		&authorDateString,
+		&commit.AuthorLogin,
+		&commit.AuthorID,
+		&commit.CommitterName,
+		&commit.CommitterEmail,
+		&commit.CommitterLogin,
+		&commit.CommitterID,
+		&conventional.Type,
+		&conventional.Scope,
+		&conventional.Subject,
+		&conventional.Breaking,
+		&verification.Verified,
+		&verification.Reason,
+		&verification.Signature,
+		&commit.Unreachable,
 	); err != nil {
 		return nil, err
 	}
 
+	// Commits mirrored before verification was tracked have no reason.
+	if verification.Reason != "" {
+		commit.Verification = &verification
+	}
+
+	if conventional.Type != "" {
+		commit.Conventional = &conventional
+	}
+
 	commit.Date, err = time.Parse(time.RFC3339, dateString)
 	if err != nil {
 		return nil, err
 	}
 
+	// Commits stored by older versions only carry the committer date.
+	if authorDateString != "" {
+		commit.AuthorDate, err = time.Parse(time.RFC3339, authorDateString)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	commit.ParentCommitIDs, err = deserializeParentCommitIds(serializedParentCommitIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	//time.Parse()
 	return &commit, nil
 }
 
 func (s sqliteRepo) GetLastCommit(ctx context.Context, owner *models.OwnerAndRepoName, startTime *time.Time) (*models.Commit, error) {
-	clause := `SELECT * from commits WHERE owner_name = ? AND repo_name = ?`
+	clause := fmt.Sprintf(`SELECT %s FROM %s WHERE c.host = ? AND c.owner_name = ? AND c.repo_name = ?`, commitColumns, commitsFrom)
 	if startTime != nil {
-		clause = fmt.Sprintf("%s AND commit_date >= '%s'", clause, startTime.Format(time.RFC3339))
+		clause = fmt.Sprintf("%s AND o.commit_date >= '%s'", clause, startTime.Format(time.RFC3339))
 	}
 
-	query := fmt.Sprintf(`%s ORDER BY commit_date DESC LIMIT 1`, clause)
+	query := fmt.Sprintf(`%s ORDER BY o.commit_date DESC LIMIT 1`, clause)
 	row := s.dataStore.QueryRowContext(ctx,
-		query, owner.OwnerName, owner.RepoName)
-	return scanCommitRow(row)
+		query, owner.Host, owner.OwnerName, owner.RepoName)
+	return scanCommit(row)
 }
 
-func (s sqliteRepo) ListCommits(ctx context.Context, filter models.CommitFilters) ([]*models.Commit, error) {
+// commitFilterClause builds the WHERE clause over commitsFrom for filter, leaving out paging and grouping.
+func commitFilterClause(filter models.CommitFilters) (string, []any) {
+	clause := `c.host = ? AND c.owner_name = ? AND c.repo_name = ?`
+	if filter.FromDate != nil {
+		clause = fmt.Sprintf("%s AND o.commit_date >= '%s'", clause, filter.FromDate.Format(time.RFC3339))
+	}
 
-	if filter.Limit <= 0 {
-		filter.Limit = 100
+	if filter.ToDate != nil {
+		clause = fmt.Sprintf(`%s AND o.commit_date <= '%s'`, clause, filter.ToDate.Format(time.RFC3339))
 	}
 
-	clause := `SELECT * from commits WHERE owner_name = ? AND repo_name = ?`
-	if filter.FromDate != nil {
-		clause = fmt.Sprintf("%s AND commit_date >= '%s'", clause, filter.FromDate.Format(time.RFC3339))
+	args := []any{filter.Host, filter.OwnerName, filter.RepoName}
+	if filter.Branch != "" {
+		clause = fmt.Sprintf("%s AND %s", clause, onBranchClause)
+		args = append(args, filter.Branch)
 	}
 
-	if filter.ToDate != nil {
-		clause = fmt.Sprintf(`%s AND commit_date <= '%s'`, clause, filter.ToDate.Format(time.RFC3339))
+	if filter.Author != "" {
+		clause = fmt.Sprintf("%s AND %s", clause, authorMatchClause)
+		args = append(args, filter.Author, filter.Author, filter.Author, filter.Author, filter.Author)
 	}
 
-	query := fmt.Sprintf(`%s ORDER BY commit_date DESC LIMIT ? OFFSET ?`, clause)
+	if filter.Committer != "" {
+		clause = fmt.Sprintf("%s AND %s", clause, committerMatchClause)
+		args = append(args, filter.Committer, filter.Committer, filter.Committer)
+	}
 
-	rows, err := s.dataStore.QueryContext(ctx, query,
-		filter.OwnerName,
-		filter.RepoName,
-		filter.Limit,
-		filter.Page,
-	)
+	if filter.Type != "" {
+		clause = fmt.Sprintf("%s AND o.cc_type = ? COLLATE NOCASE", clause)
+		args = append(args, filter.Type)
+	}
+
+	if filter.Scope != "" {
+		clause = fmt.Sprintf("%s AND o.cc_scope = ? COLLATE NOCASE", clause)
+		args = append(args, filter.Scope)
+	}
+
+	if filter.BreakingOnly {
+		clause = fmt.Sprintf("%s AND o.cc_breaking = 1", clause)
+	}
+
+	switch filter.CIConclusion {
+	case models.CIConclusionSuccess, models.CIConclusionFailure, models.CIConclusionPending, models.CIConclusionNone:
+		clause = fmt.Sprintf(`%s AND EXISTS (
+			SELECT 1 FROM commit_checks k
+			WHERE k.host = c.host AND k.owner_name = c.owner_name AND k.repo_name = c.repo_name AND k.sha = c.sha AND k.conclusion = ?
+		)`, clause)
+		args = append(args, filter.CIConclusion)
+	}
+
+	switch filter.Verification {
+	case models.VerificationVerified:
+		clause = fmt.Sprintf("%s AND o.verified = 1", clause)
+	case models.VerificationUnverified:
+		clause = fmt.Sprintf("%s AND o.verified = 0 AND o.verification_reason != ''", clause)
+	case models.VerificationUnsigned:
+		clause = fmt.Sprintf("%s AND o.verification_reason = 'unsigned'", clause)
+	}
+
+	return clause, args
+}
+
+func (s sqliteRepo) ListCommits(ctx context.Context, filter models.CommitFilters) ([]*models.Commit, error) {
+
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+
+	where, args := commitFilterClause(filter)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY o.commit_date DESC LIMIT ? OFFSET ?`, commitColumns, commitsFrom, where)
+	args = append(args, filter.Limit, filter.Page)
+
+	return s.queryCommits(ctx, query, args...)
+}
+
+// queryCommits runs a query selecting commitColumns and loads the branches, trailers and pull requests of each commit.
+func (s sqliteRepo) queryCommits(ctx context.Context, query string, args ...any) ([]*models.Commit, error) {
+	rows, err := s.dataStore.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -137,7 +312,7 @@ func (s sqliteRepo) ListCommits(ctx context.Context, filter models.CommitFilters
 	var commits []*models.Commit
 	defer rows.Close()
 	for rows.Next() {
-		commit, err := scanCommitRows(rows)
+		commit, err := scanCommit(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -145,55 +320,184 @@ func (s sqliteRepo) ListCommits(ctx context.Context, filter models.CommitFilters
 		commits = append(commits, commit)
 	}
 
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, commit := range commits {
+		if err = s.loadCommitBranches(ctx, commit); err != nil {
+			return nil, err
+		}
+
+		if err = s.loadCommitTrailers(ctx, commit); err != nil {
+			return nil, err
+		}
+
+		if err = s.loadCommitPullRequests(ctx, commit); err != nil {
+			return nil, err
+		}
+	}
+
 	return commits, nil
 }
 
+// GetCommitBySHA returns the commit as stored for owner, along with every monitored repository that contains it.
+// An empty owner matches the SHA in any repository, on any host.
 func (s sqliteRepo) GetCommitBySHA(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.Commit, error) {
-	row := s.dataStore.QueryRowContext(ctx, "SELECT * from commits WHERE owner_name = ? AND repo_name = ? AND sha = ? LIMIT 1", owner.OwnerName, owner.RepoName, sha)
-	return scanCommitRow(row)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE c.sha = ?`, commitColumns, commitsFrom)
+	args := []any{sha}
+	if owner.OwnerName != "" || owner.RepoName != "" {
+		query = fmt.Sprintf(`%s AND c.host = ? AND c.owner_name = ? AND c.repo_name = ?`, query)
+		args = append(args, owner.Host, owner.OwnerName, owner.RepoName)
+	}
+
+	row := s.dataStore.QueryRowContext(ctx, fmt.Sprintf(`%s LIMIT 1`, query), args...)
+	commit, err := scanCommit(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitBranches(ctx, commit); err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitTrailers(ctx, commit); err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitPullRequests(ctx, commit); err != nil {
+		return nil, err
+	}
+
+	if err = s.loadCommitRepositories(ctx, commit); err != nil {
+		return nil, err
+	}
+
+	return commit, nil
+}
+
+func (s sqliteRepo) loadCommitRepositories(ctx context.Context, commit *models.Commit) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT host, owner_name, repo_name FROM commits WHERE sha = ? ORDER BY host, owner_name, repo_name`, commit.SHA)
+	if err != nil {
+		return err
+	}
+
+	commit.Repositories = make([]models.OwnerAndRepoName, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var repo models.OwnerAndRepoName
+		if err := rows.Scan(&repo.Host, &repo.OwnerName, &repo.RepoName); err != nil {
+			return err
+		}
+
+		commit.Repositories = append(commit.Repositories, repo)
+	}
+
+	return rows.Err()
 }
 
+// SaveCommit stores a commit in its repository, leaving commits already stored there as they are, e.g. when a push
+// webhook and a scheduled sync ingest the same commit at once.
 func (s sqliteRepo) SaveCommit(ctx context.Context, commit *models.Commit) error {
-	if existingCommit, _ := s.GetCommitBySHA(ctx, models.OwnerAndRepoName{
-		OwnerName: commit.OwnerName,
-		RepoName:  commit.RepoName,
-	}, commit.SHA); existingCommit != nil {
-		// TODO: carry out commit update on disk this is in the case a commit message was updated via the git append command from it's source.
-		return nil
+	serializedParentCommitIds, err := json.Marshal(commit.ParentCommitIDs)
+	if err != nil {
+		return err
 	}
 
-	insertSQL := `
-        INSERT INTO commits (
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The body may already be stored by another repository containing the same commit.
+	insertObjectSQL := `
+        INSERT OR IGNORE INTO commit_objects (
             sha,
 			message,
 			author,
-			repo_name,
-			owner_name,
-			url,
 			parent_commit_ids,
-			commit_date           
+			commit_date,
+			author_email,
+			author_date,
+			author_login,
+			author_id,
+			committer_name,
+			committer_email,
+			committer_login,
+			committer_id,
+			cc_type,
+			cc_scope,
+			cc_subject,
+			cc_breaking,
+			verified,
+			verification_reason,
+			signature,
+			additions,
+			deletions,
+			cc_parsed
 		)
-        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`
 
-	serializedParentCommitIds, err := json.Marshal(commit.ParentCommitIDs)
-	if err != nil {
-		return err
+	var authorDate string
+	if !commit.AuthorDate.IsZero() {
+		authorDate = commit.AuthorDate.Format(time.RFC3339)
 	}
 
-	fmt.Println("commit.sha and parent: ", commit.SHA, string(serializedParentCommitIds))
-
-	_, err = s.dataStore.ExecContext(ctx, insertSQL,
+	args := []any{
 		commit.SHA,
 		commit.Message,
 		commit.Author,
-		commit.RepoName,
-		commit.OwnerName,
-		commit.URL,
 		string(serializedParentCommitIds),
 		commit.Date.Format(time.RFC3339),
-	)
+		commit.AuthorEmail,
+		authorDate,
+		commit.AuthorLogin,
+		commit.AuthorID,
+		commit.CommitterName,
+		commit.CommitterEmail,
+		commit.CommitterLogin,
+		commit.CommitterID,
+	}
+	args = append(args, conventionalColumns(models.ParseConventionalCommit(commit.Message))...)
+	if verification := commit.Verification; verification != nil {
+		args = append(args, verification.Verified, verification.Reason, verification.Signature)
+	} else {
+		args = append(args, false, "", "")
+	}
+	args = append(args, commit.Additions, commit.Deletions) // Only known up front when listed through GraphQL.
+
+	if _, err = tx.ExecContext(ctx, insertObjectSQL, args...); err != nil {
+		return err
+	}
+
+	if err = saveCommitTrailers(ctx, tx, commit.SHA, models.ParseTrailers(commit.Message)); err != nil {
+		return err
+	}
 
-	return err
+	// TODO: carry out commit update on disk this is in the case a commit message was updated via the git append command from it's source.
+	insertSQL := `
+        INSERT OR IGNORE INTO commits (
+			host,
+			owner_name,
+			repo_name,
+			sha,
+			url
+		)
+        VALUES (?, ?, ?, ?, ?)`
+
+	if _, err = tx.ExecContext(ctx, insertSQL,
+		commit.Host,
+		commit.OwnerName,
+		commit.RepoName,
+		commit.SHA,
+		commit.URL,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (s sqliteRepo) GetTopCommitAuthors(ctx context.Context, filter models.CommitFilters) ([]*models.TopCommitAuthor, error) {
@@ -201,23 +505,25 @@ func (s sqliteRepo) GetTopCommitAuthors(ctx context.Context, filter models.Commi
 		filter.Limit = 100
 	}
 
-	clause := `SELECT author, COUNT(*) as commit_count FROM commits WHERE owner_name = ? AND repo_name = ?`
-	if filter.FromDate != nil {
-		clause = fmt.Sprintf("%s AND commit_date >= '%s'", clause, filter.FromDate.Format(time.RFC3339))
+	groupBy, ok := authorGroupByColumns[filter.GroupBy]
+	if !ok {
+		groupBy = authorGroupByColumns[models.AuthorGroupByName]
 	}
 
-	if filter.ToDate != nil {
-		clause = fmt.Sprintf(`%s AND commit_date <= '%s'`, clause, filter.ToDate.Format(time.RFC3339))
+	where, args := commitFilterClause(filter)
+	identities := fmt.Sprintf(commitIdentities, where)
+	if filter.IncludeCoAuthors {
+		identities = fmt.Sprintf("%s UNION ALL %s", identities, fmt.Sprintf(coAuthorIdentities, where))
+		args = append(args, args...)
 	}
 
-	query := fmt.Sprintf(`%s GROUP BY author ORDER BY commit_count DESC LIMIT ? OFFSET ?`, clause)
+	// A commit counts once per identity, even when its author also lists themselves as co-author.
+	// Identities without the key we group by, e.g. authors with no linked GitHub account, are left out.
+	query := fmt.Sprintf(`SELECT %s, COUNT(DISTINCT sha) AS commit_count, COUNT(DISTINCT CASE WHEN co_authored THEN sha END)
+		FROM (%s) WHERE %s != '' GROUP BY %s ORDER BY commit_count DESC LIMIT ? OFFSET ?`, groupBy, identities, groupBy, groupBy)
+	args = append(args, filter.Limit, filter.Page)
 
-	rows, err := s.dataStore.QueryContext(ctx, query,
-		filter.OwnerName,
-		filter.RepoName,
-		filter.Limit,
-		filter.Page,
-	)
+	rows, err := s.dataStore.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -229,6 +535,7 @@ func (s sqliteRepo) GetTopCommitAuthors(ctx context.Context, filter models.Commi
 		if err = rows.Scan(
 			&author.Author,
 			&author.CommitCount,
+			&author.CoAuthoredCount,
 		); err != nil {
 			return nil, err
 		}
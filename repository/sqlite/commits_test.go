@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestSaveCommitConcurrently(t *testing.T) {
+	dataStore := newTestDataStore(t)
+	ctx := context.Background()
+	commit := &models.Commit{
+		Date:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Message:   "feat: first",
+		Author:    "A",
+		OwnerName: "o",
+		RepoName:  "r",
+		SHA:       "s1",
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- dataStore.SaveCommit(ctx, commit)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("SaveCommit() error = %v", err)
+		}
+	}
+
+	list, err := dataStore.ListCommits(ctx, models.CommitFilters{OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}})
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListCommits() = %v, %v, want the commit once", list, err)
+	}
+}
@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"database/sql"
+	"gitbeam.commit.monitor/models"
+)
+
+// setupConventionalCommitColumns adds the Conventional Commits classification to commit bodies.
+// Commits stored before it existed are classified in the background, see reparseCommitMessages.
+func setupConventionalCommitColumns(db *sql.DB) error {
+	columns := []struct{ name, definition string }{
+		{"cc_type", "TEXT NOT NULL DEFAULT ''"},
+		{"cc_scope", "TEXT NOT NULL DEFAULT ''"},
+		{"cc_subject", "TEXT NOT NULL DEFAULT ''"},
+		{"cc_breaking", "INTEGER NOT NULL DEFAULT 0"},
+		{"cc_parsed", "INTEGER NOT NULL DEFAULT 0"},
+	}
+
+	for _, column := range columns {
+		if err := addColumnIfMissing(db, "commit_objects", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveConventionalCommit stores the classification of a commit body stored before it was classified.
+func saveConventionalCommit(tx *sql.Tx, sha, message string) error {
+	conventional := conventionalColumns(models.ParseConventionalCommit(message))
+	_, err := tx.Exec(`UPDATE commit_objects SET cc_type = ?, cc_scope = ?, cc_subject = ?, cc_breaking = ? WHERE sha = ?`,
+		append(conventional, sha)...)
+	return err
+}
+
+// conventionalColumns returns the cc_type, cc_scope, cc_subject and cc_breaking values of a classification.
+func conventionalColumns(conventional *models.ConventionalCommit) []any {
+	if conventional == nil {
+		return []any{"", "", "", false}
+	}
+	return []any{conventional.Type, conventional.Scope, conventional.Subject, conventional.Breaking}
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+const historyRewritesTableSetup = `
+CREATE TABLE IF NOT EXISTS history_rewrites (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		branch TEXT,
+		old_head_sha TEXT,
+		new_head_sha TEXT,
+		orphaned_shas TEXT,
+		detected_at DATETIME
+)
+`
+
+func setupHistoryRewritesTable(db *sql.DB) error {
+	if _, err := db.Exec(historyRewritesTableSetup); err != nil {
+		return err
+	}
+
+	return addColumnIfMissing(db, "history_rewrites", "host", "TEXT NOT NULL DEFAULT ''")
+}
+
+// SaveHistoryRewrite records the incident and, in the same transaction, takes the orphaned commits off the branch,
+// marking those no other mirrored branch contains as unreachable.
+func (s sqliteRepo) SaveHistoryRewrite(ctx context.Context, rewrite *models.HistoryRewrite) error {
+	orphanedSHAs, err := json.Marshal(rewrite.OrphanedSHAs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insertSQL := `
+        INSERT INTO history_rewrites (
+			host,
+			owner_name,
+			repo_name,
+			branch,
+			old_head_sha,
+			new_head_sha,
+			orphaned_shas,
+			detected_at
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := tx.ExecContext(ctx, insertSQL,
+		rewrite.Host,
+		rewrite.OwnerName,
+		rewrite.RepoName,
+		rewrite.Branch,
+		rewrite.OldHeadSHA,
+		rewrite.NewHeadSHA,
+		string(orphanedSHAs),
+		rewrite.DetectedAt.Format(time.RFC3339),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, sha := range rewrite.OrphanedSHAs {
+		if _, err = tx.ExecContext(ctx,
+			`DELETE FROM commit_branches WHERE host = ? AND owner_name = ? AND repo_name = ? AND branch = ? AND sha = ?`,
+			rewrite.Host, rewrite.OwnerName, rewrite.RepoName, rewrite.Branch, sha); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE commits SET unreachable = 1 WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ? AND NOT EXISTS (
+				SELECT 1 FROM commit_branches b
+				WHERE b.host = commits.host AND b.owner_name = commits.owner_name AND b.repo_name = commits.repo_name AND b.sha = commits.sha
+			)`,
+			rewrite.Host, rewrite.OwnerName, rewrite.RepoName, sha); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	rewrite.ID, err = result.LastInsertId()
+	return err
+}
+
+func (s sqliteRepo) ListHistoryRewrites(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.HistoryRewrite, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT id, host, owner_name, repo_name, branch, old_head_sha, new_head_sha, orphaned_shas, detected_at
+		FROM history_rewrites WHERE host = ? AND owner_name = ? AND repo_name = ? ORDER BY detected_at DESC, id DESC`,
+		owner.Host, owner.OwnerName, owner.RepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.HistoryRewrite, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var rewrite models.HistoryRewrite
+		var orphanedSHAs, detectedAt string
+		if err := rows.Scan(
+			&rewrite.ID,
+			&rewrite.Host,
+			&rewrite.OwnerName,
+			&rewrite.RepoName,
+			&rewrite.Branch,
+			&rewrite.OldHeadSHA,
+			&rewrite.NewHeadSHA,
+			&orphanedSHAs,
+			&detectedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal([]byte(orphanedSHAs), &rewrite.OrphanedSHAs); err != nil {
+			return nil, err
+		}
+
+		if rewrite.DetectedAt, err = time.Parse(time.RFC3339, detectedAt); err != nil {
+			return nil, err
+		}
+
+		list = append(list, &rewrite)
+	}
+
+	return list, nil
+}
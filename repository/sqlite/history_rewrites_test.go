@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestSaveHistoryRewrite(t *testing.T) {
+	dataStore := newTestDataStore(t)
+	ctx := context.Background()
+	owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+
+	// a - b - c on main, release branched off at b. main is then force pushed back to a.
+	branches := map[string][]string{
+		"a": {"main", "release"},
+		"b": {"main", "release"},
+		"c": {"main"},
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, sha := range []string{"a", "b", "c"} {
+		if err := dataStore.SaveCommit(ctx, &models.Commit{
+			Date:      start.Add(time.Duration(i) * time.Hour),
+			Host:      owner.Host,
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+			SHA:       sha,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		for _, branch := range branches[sha] {
+			if err := dataStore.SaveCommitBranch(ctx, owner, sha, branch); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	rewrite := &models.HistoryRewrite{
+		DetectedAt:   start.Add(24 * time.Hour),
+		Host:         owner.Host,
+		OwnerName:    owner.OwnerName,
+		RepoName:     owner.RepoName,
+		Branch:       "main",
+		OldHeadSHA:   "c",
+		NewHeadSHA:   "a",
+		OrphanedSHAs: []string{"c", "b"},
+	}
+	if err := dataStore.SaveHistoryRewrite(ctx, rewrite); err != nil {
+		t.Fatal(err)
+	}
+	if rewrite.ID == 0 {
+		t.Error("SaveHistoryRewrite() left the ID unset")
+	}
+
+	tests := []struct {
+		sha             string
+		wantOnMain      bool
+		wantUnreachable bool
+	}{
+		{"a", true, false},
+		{"b", false, false}, // Still reached by release.
+		{"c", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sha, func(t *testing.T) {
+			onMain, err := dataStore.IsCommitOnBranch(ctx, owner, tt.sha, "main")
+			if err != nil || onMain != tt.wantOnMain {
+				t.Errorf("IsCommitOnBranch(main) = %v, %v, want %v", onMain, err, tt.wantOnMain)
+			}
+
+			commit, err := dataStore.GetCommitBySHA(ctx, owner, tt.sha)
+			if err != nil || commit.Unreachable != tt.wantUnreachable {
+				t.Errorf("GetCommitBySHA() = %+v, %v, want unreachable %v", commit, err, tt.wantUnreachable)
+			}
+		})
+	}
+
+	list, err := dataStore.ListHistoryRewrites(ctx, owner)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListHistoryRewrites() = %v, %v, want the rewrite", list, err)
+	}
+	if got := list[0]; got.ID != rewrite.ID || !got.DetectedAt.Equal(rewrite.DetectedAt) ||
+		fmt.Sprint(got.OrphanedSHAs) != "[c b]" || got.OldHeadSHA != "c" || got.NewHeadSHA != "a" {
+		t.Errorf("ListHistoryRewrites() = %+v, want %+v", got, rewrite)
+	}
+}
@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"strings"
+)
+
+// addColumnIfMissing brings tables created by older versions of the service up to date.
+// SQLite has no ADD COLUMN IF NOT EXISTS, so the table's columns are checked first.
+func addColumnIfMissing(db *sql.DB, table, column, definition string) error {
+	exists, err := hasColumn(db, table, column)
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+func hasColumn(db *sql.DB, table, column string) (bool, error) {
+	columns, err := tableColumns(db, table)
+	if err != nil {
+		return false, err
+	}
+
+	for _, name := range columns {
+		if name == column {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// reparseBatchSize is how many commit bodies reparseCommitMessages parses per transaction, which keeps the
+// database locked for about as long as the service's own writes.
+const reparseBatchSize = 200
+
+// reparseFlags are the columns marking the commit bodies whose messages have been parsed into the columns and tables
+// derived from them, along with what parses them. Bodies stored before one of them existed have it unset.
+var reparseFlags = []struct {
+	column string
+	parse  func(tx *sql.Tx, sha, message string) error
+}{
+	{"trailers_parsed", func(tx *sql.Tx, sha, message string) error {
+		return saveCommitTrailers(context.Background(), tx, sha, models.ParseTrailers(message))
+	}},
+	{"cc_parsed", saveConventionalCommit},
+}
+
+// hasUnparsedMessages reports whether commit bodies are waiting for reparseCommitMessages.
+func hasUnparsedMessages(db *sql.DB) (bool, error) {
+	conditions := make([]string, 0, len(reparseFlags))
+	for _, flag := range reparseFlags {
+		conditions = append(conditions, flag.column+" = 0")
+	}
+
+	var pending bool
+	err := db.QueryRow(fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM commit_objects WHERE %s)", strings.Join(conditions, " OR "))).Scan(&pending)
+	return pending, err
+}
+
+// reparseCommitMessages parses the messages of the commit bodies stored before a column derived from them existed,
+// which have flagColumn unset. parse stores what it derived from a message, then flagColumn is set.
+//
+// Parsing every body of a large database takes a while, so NewSqliteRepo runs it in the background instead of
+// holding up the start of the service, a batch per transaction. Until then the bodies read as having no trailers or classification. It stops at the
+// first error, the bodies left are parsed on the next start.
+func reparseCommitMessages(db *sql.DB, flagColumn string, parse func(tx *sql.Tx, sha, message string) error) error {
+	for {
+		parsed, err := reparseBatch(db, flagColumn, parse)
+		if err != nil || parsed < reparseBatchSize {
+			return err
+		}
+	}
+}
+
+// reparseBatch parses up to reparseBatchSize bodies with flagColumn unset in one transaction, returning how many.
+func reparseBatch(db *sql.DB, flagColumn string, parse func(tx *sql.Tx, sha, message string) error) (int, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(fmt.Sprintf("SELECT sha, message FROM commit_objects WHERE %s = 0 LIMIT ?", flagColumn), reparseBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	type body struct{ sha, message string }
+	bodies := make([]body, 0, reparseBatchSize)
+	for rows.Next() {
+		var sha string
+		var message sql.NullString
+		if err := rows.Scan(&sha, &message); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		bodies = append(bodies, body{sha, message.String})
+	}
+
+	if err = rows.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, body := range bodies {
+		if err = parse(tx, body.sha, body.message); err != nil {
+			return 0, err
+		}
+		if _, err = tx.Exec(fmt.Sprintf("UPDATE commit_objects SET %s = 1 WHERE sha = ?", flagColumn), body.sha); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(bodies), tx.Commit()
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Tx.
+type queryer interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// tableColumns lists the columns of a table, which is empty when the table doesn't exist.
+func tableColumns(db queryer, table string) ([]string, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make([]string, 0)
+	for rows.Next() {
+		var cid, notNull, primaryKey int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return nil, err
+		}
+
+		columns = append(columns, name)
+	}
+
+	return columns, rows.Err()
+}
+
+// migrateToHostKey rebuilds a table created before repositories were keyed by host with setup, which declares
+// its current schema. SQLite can't change a table's unique constraints in place, so the rows are copied over.
+func migrateToHostKey(db *sql.DB, table, setup string) error {
+	columns, err := tableColumns(db, table)
+	if err != nil || len(columns) == 0 {
+		return err
+	}
+
+	for _, column := range columns {
+		if column == "host" {
+			return nil
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s_legacy", table, table)); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(setup); err != nil {
+		return err
+	}
+
+	// Older versions may lack columns added since, those keep their defaults.
+	current, err := tableColumns(tx, table)
+	if err != nil {
+		return err
+	}
+
+	shared := make([]string, 0, len(columns))
+	for _, column := range columns {
+		for _, name := range current {
+			if name == column {
+				shared = append(shared, column)
+				break
+			}
+		}
+	}
+
+	statements := []string{
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s_legacy", table, strings.Join(shared, ", "), strings.Join(shared, ", "), table),
+		fmt.Sprintf("DROP TABLE %s_legacy", table),
+		setup, // Indexes moved along with the legacy table, so they are created again.
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,315 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/repository"
+)
+
+// seedDatabase runs statements against a new in-memory database, e.g. to create the schema of an older version,
+// and returns its name. The database lives until the test ends.
+func seedDatabase(t *testing.T, statements ...string) string {
+	t.Helper()
+	name := memoryDatabase(t)
+	db, err := sql.Open("sqlite3", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return name
+}
+
+// openMigrated opens the database name with NewSqliteRepo, once the messages of the commits it stored before
+// parsing them have been parsed.
+func openMigrated(t *testing.T, name string) repository.DataStore {
+	t.Helper()
+	dataStore, err := NewSqliteRepo(name)
+	if err != nil {
+		t.Fatalf("NewSqliteRepo() error = %v", err)
+	}
+
+	dataStore.(*sqliteRepo).reparsing.Wait()
+	t.Cleanup(func() { _ = dataStore.(*sqliteRepo).dataStore.Close() })
+	return dataStore
+}
+
+// openCronStore opens the database name with NewSqliteCronStore, closing it when the test ends.
+func openCronStore(t *testing.T, name string) repository.CronServiceStore {
+	t.Helper()
+	cronStore, err := NewSqliteCronStore(name)
+	if err != nil {
+		t.Fatalf("NewSqliteCronStore() error = %v", err)
+	}
+
+	t.Cleanup(func() { _ = cronStore.(*sqliteRepo).dataStore.Close() })
+	return cronStore
+}
+
+// checkColumnsAsCreated fails the test unless the tables of the migrated database have the columns, in order,
+// of the same tables created from scratch. Rows are scanned by position, so the order matters.
+func checkColumnsAsCreated(t *testing.T, migrated string, tables ...string) {
+	t.Helper()
+	fresh, err := NewSqliteRepo(strings.Replace(memoryDatabase(t), "?", "_fresh?", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	freshDB := fresh.(*sqliteRepo).dataStore
+	defer freshDB.Close()
+
+	migratedDB, err := sql.Open("sqlite3", migrated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer migratedDB.Close()
+
+	for _, table := range tables {
+		got, err := tableColumns(migratedDB, table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := tableColumns(freshDB, table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(want) == 0 || !reflect.DeepEqual(got, want) {
+			t.Errorf("columns of %s = %v, want %v", table, got, want)
+		}
+	}
+}
+
+func TestMigrateLegacyCommitsTable(t *testing.T) {
+	// The commits table of the first versions, keyed by SHA alone.
+	name := seedDatabase(t, `
+		CREATE TABLE IF NOT EXISTS commits (
+			sha TEXT PRIMARY KEY,
+			message TEXT,
+			author TEXT,
+			repo_name TEXT,
+			owner_name TEXT,
+			url TEXT,
+			parent_commit_ids TEXT,
+			commit_date DATETIME,
+			UNIQUE (repo_name, owner_name, sha)
+		)`,
+		`INSERT INTO commits VALUES ('s1', 'feat: first', 'A', 'r', 'o', 'u1', '[]', '2024-01-01T00:00:00Z')`,
+		`INSERT INTO commits VALUES ('s2', 'fix: second', 'B', 'r', 'o', 'u2', '["s1"]', '2024-01-02T00:00:00Z')`,
+	)
+
+	// Setup runs on every start, so migrating again must leave the database as it is.
+	for run := 1; run <= 2; run++ {
+		openMigrated(t, name)
+	}
+	checkColumnsAsCreated(t, name, "commits", "commit_objects")
+
+	dataStore := openMigrated(t, name)
+
+	ctx := context.Background()
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	commits, err := dataStore.ListCommits(ctx, models.CommitFilters{OwnerAndRepoName: owner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		conventional := ""
+		if commit.Conventional != nil {
+			conventional = commit.Conventional.Type
+		}
+		got = append(got, fmt.Sprintf("%s %s %s %s %v %s", commit.SHA, commit.Message, commit.Author, commit.URL, commit.ParentCommitIDs, conventional))
+	}
+	want := []string{"s2 fix: second B u2 [s1] fix", "s1 feat: first A u1 [] feat"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCommits() = %q, want %q", got, want)
+	}
+
+	// The migrated commits can be stored again, e.g. when another branch contains them.
+	if err := dataStore.SaveCommit(ctx, commits[0]); err != nil {
+		t.Errorf("SaveCommit() error = %v", err)
+	}
+}
+
+func TestMigrateCronTasksToHostKey(t *testing.T) {
+	// DATETIME columns read back in RFC 3339.
+	from, to := "2024-01-01T00:00:00Z", "2024-06-01T00:00:00Z"
+
+	tests := []struct {
+		name   string
+		schema string // cron_tasks as an older version created it.
+		row    string
+		want   models.MonitorRepositoryCommitConfig
+	}{
+		{
+			name: "baseline",
+			schema: `CREATE TABLE cron_tasks (
+				repo_name TEXT,
+				owner_name TEXT,
+				from_date DATETIME,
+				to_date DATETIME,
+				duration_in_hours INTEGER,
+				UNIQUE (repo_name, owner_name)
+			)`,
+			row: `INSERT INTO cron_tasks VALUES ('r', 'o', '2024-01-01', '2024-06-01', 24)`,
+			want: models.MonitorRepositoryCommitConfig{
+				OwnerName: "o", RepoName: "r", FromDate: from, ToDate: to, DurationInHours: 24, Branches: []string{},
+			},
+		},
+		{
+			name: "with branches",
+			schema: `CREATE TABLE cron_tasks (
+				repo_name TEXT,
+				owner_name TEXT,
+				from_date DATETIME,
+				to_date DATETIME,
+				duration_in_hours INTEGER,
+				UNIQUE (repo_name, owner_name)
+			);
+			ALTER TABLE cron_tasks ADD COLUMN branches TEXT NOT NULL DEFAULT '[]'`,
+			row: `INSERT INTO cron_tasks VALUES ('r', 'o', '2024-01-01', '2024-06-01', 24, '["main","release/*"]')`,
+			want: models.MonitorRepositoryCommitConfig{
+				OwnerName: "o", RepoName: "r", FromDate: from, ToDate: to, DurationInHours: 24, Branches: []string{"main", "release/*"},
+			},
+		},
+		{
+			name: "keyed by host",
+			schema: `CREATE TABLE cron_tasks (
+				repo_name TEXT,
+				owner_name TEXT,
+				from_date DATETIME,
+				to_date DATETIME,
+				duration_in_hours INTEGER,
+				branches TEXT NOT NULL DEFAULT '[]',
+				host TEXT NOT NULL DEFAULT '',
+				UNIQUE (host, repo_name, owner_name)
+			)`,
+			row: `INSERT INTO cron_tasks VALUES ('r', 'o', '2024-01-01', '2024-06-01', 24, '[]', 'ghe')`,
+			want: models.MonitorRepositoryCommitConfig{
+				Host: "ghe", OwnerName: "o", RepoName: "r", FromDate: from, ToDate: to, DurationInHours: 24, Branches: []string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := seedDatabase(t, tt.schema, tt.row)
+
+			// Setup runs on every start, so migrating again must leave the database as it is.
+			for run := 1; run <= 2; run++ {
+				openCronStore(t, name)
+			}
+			checkColumnsAsCreated(t, name, "cron_tasks")
+
+			cronStore := openCronStore(t, name)
+
+			want := tt.want
+			want.Provider, want.Fetcher, want.Status = models.ProviderGithub, models.FetcherREST, models.MonitorPending
+
+			configs, err := cronStore.ListMonitorConfig(context.Background())
+			if err != nil || len(configs) != 1 {
+				t.Fatalf("ListMonitorConfig() = %v, %v, want the migrated monitor", configs, err)
+			}
+			if !reflect.DeepEqual(*configs[0], want) {
+				t.Errorf("ListMonitorConfig() = %+v, want %+v", *configs[0], want)
+			}
+
+			config, err := cronStore.GetMonitorConfig(context.Background(), want.OwnerAndRepoName())
+			if err != nil || !reflect.DeepEqual(*config, want) {
+				t.Errorf("GetMonitorConfig() = %+v, %v, want %+v", config, err, want)
+			}
+		})
+	}
+}
+
+func TestMigrateCommitsToHostKey(t *testing.T) {
+	// The commits table split from commit_objects, before repositories were keyed by host.
+	name := seedDatabase(t, `
+		CREATE TABLE commit_objects (
+			sha TEXT PRIMARY KEY,
+			message TEXT,
+			author TEXT,
+			parent_commit_ids TEXT,
+			commit_date DATETIME
+		);
+		CREATE TABLE commits (
+			owner_name TEXT,
+			repo_name TEXT,
+			sha TEXT,
+			url TEXT,
+			UNIQUE (owner_name, repo_name, sha)
+		);
+		ALTER TABLE commits ADD COLUMN unreachable INTEGER NOT NULL DEFAULT 0`,
+		`INSERT INTO commit_objects VALUES ('s1', 'feat: first', 'A', '[]', '2024-01-01T00:00:00Z')`,
+		`INSERT INTO commits VALUES ('o', 'r', 's1', 'u1', 0), ('fork', 'r', 's1', 'u2', 1)`,
+	)
+
+	for run := 1; run <= 2; run++ {
+		openMigrated(t, name)
+	}
+	checkColumnsAsCreated(t, name, "commits", "commit_objects")
+
+	dataStore := openMigrated(t, name)
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		owner           models.OwnerAndRepoName
+		wantURL         string
+		wantUnreachable bool
+	}{
+		{models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}, "u1", false},
+		{models.OwnerAndRepoName{OwnerName: "fork", RepoName: "r"}, "u2", true},
+	} {
+		commit, err := dataStore.GetCommitBySHA(ctx, tt.owner, "s1")
+		if err != nil {
+			t.Fatalf("GetCommitBySHA(%v) error = %v", tt.owner, err)
+		}
+		if commit.Host != "" || commit.Message != "feat: first" || commit.URL != tt.wantURL || commit.Unreachable != tt.wantUnreachable {
+			t.Errorf("GetCommitBySHA(%v) = %+v", tt.owner, commit)
+		}
+	}
+}
+
+func TestReparseCommitMessages(t *testing.T) {
+	// More commit bodies than fit in a batch, stored before trailers and classifications were parsed.
+	rows := make([]string, 0, 2*reparseBatchSize+1)
+	for i := 0; i < cap(rows); i++ {
+		rows = append(rows, fmt.Sprintf("('s%d', 'feat(api): change %d\n\nSigned-off-by: A <a@example.com>', 'A', '[]', '2024-01-01T00:00:00Z')", i, i))
+	}
+	name := seedDatabase(t, `
+		CREATE TABLE commit_objects (
+			sha TEXT PRIMARY KEY,
+			message TEXT,
+			author TEXT,
+			parent_commit_ids TEXT,
+			commit_date DATETIME
+		)`,
+		"INSERT INTO commit_objects VALUES "+strings.Join(rows, ", "),
+	)
+
+	db := openMigrated(t, name).(*sqliteRepo).dataStore
+	if pending, err := hasUnparsedMessages(db); err != nil || pending {
+		t.Fatalf("hasUnparsedMessages() = %v, %v, want every message parsed", pending, err)
+	}
+
+	var classified, trailers int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM commit_objects WHERE cc_type = 'feat' AND cc_scope = 'api'`).Scan(&classified); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM commit_trailers WHERE key = 'Signed-off-by' AND email = 'a@example.com'`).Scan(&trailers); err != nil {
+		t.Fatal(err)
+	}
+	if classified != len(rows) || trailers != len(rows) {
+		t.Errorf("classified %d and parsed trailers of %d commits, want %d", classified, trailers, len(rows))
+	}
+}
@@ -0,0 +1,179 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+// Pull requests belong to the repository they were opened on, and link to every commit pushed to them.
+const pullRequestsTableSetup = `
+CREATE TABLE IF NOT EXISTS pull_requests (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		number INTEGER,
+		title TEXT,
+		state TEXT,
+		url TEXT,
+		author TEXT,
+		base_branch TEXT,
+		head_branch TEXT,
+		merged_at TEXT NOT NULL DEFAULT '',
+		UNIQUE (host, owner_name, repo_name, number)
+);
+
+CREATE TABLE IF NOT EXISTS commit_pull_requests (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		sha TEXT,
+		number INTEGER,
+		UNIQUE (host, owner_name, repo_name, sha, number)
+);
+
+CREATE INDEX IF NOT EXISTS commit_pull_requests_number ON commit_pull_requests (host, owner_name, repo_name, number);
+`
+
+// setupPullRequestsTables also marks which commits their pull requests were fetched for, as most commits have none.
+func setupPullRequestsTables(db *sql.DB) error {
+	if _, err := db.Exec(pullRequestsTableSetup); err != nil {
+		return err
+	}
+
+	return addColumnIfMissing(db, "commits", "pull_requests_fetched", "INTEGER NOT NULL DEFAULT 0")
+}
+
+// SaveCommitPullRequests records the pull requests of a commit, refreshing what we knew of them, e.g. their state,
+// and marks them as fetched for the commit, even when it has none.
+func (s sqliteRepo) SaveCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string, pullRequests []*models.PullRequest) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	upsertSQL := `
+        INSERT INTO pull_requests (
+			host,
+			owner_name,
+			repo_name,
+			number,
+			title,
+			state,
+			url,
+			author,
+			base_branch,
+			head_branch,
+			merged_at
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (host, owner_name, repo_name, number) DO UPDATE SET
+			title = excluded.title,
+			state = excluded.state,
+			url = excluded.url,
+			author = excluded.author,
+			base_branch = excluded.base_branch,
+			head_branch = excluded.head_branch,
+			merged_at = excluded.merged_at`
+
+	for _, pullRequest := range pullRequests {
+		var mergedAt string
+		if pullRequest.MergedAt != nil {
+			mergedAt = pullRequest.MergedAt.Format(time.RFC3339)
+		}
+
+		if _, err = tx.ExecContext(ctx, upsertSQL,
+			owner.Host,
+			owner.OwnerName,
+			owner.RepoName,
+			pullRequest.Number,
+			pullRequest.Title,
+			pullRequest.State,
+			pullRequest.URL,
+			pullRequest.Author,
+			pullRequest.BaseBranch,
+			pullRequest.HeadBranch,
+			mergedAt,
+		); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx,
+			`INSERT OR IGNORE INTO commit_pull_requests (host, owner_name, repo_name, sha, number) VALUES (?, ?, ?, ?, ?)`,
+			owner.Host, owner.OwnerName, owner.RepoName, sha, pullRequest.Number); err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE commits SET pull_requests_fetched = 1 WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, sha); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) HasCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) (bool, error) {
+	var fetched bool
+	err := s.dataStore.QueryRowContext(ctx,
+		`SELECT pull_requests_fetched FROM commits WHERE host = ? AND owner_name = ? AND repo_name = ? AND sha = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, sha).Scan(&fetched)
+	return fetched, err
+}
+
+// ListPullRequestCommits lists the mirrored commits of a pull request, oldest first like the pull request lists them.
+func (s sqliteRepo) ListPullRequestCommits(ctx context.Context, owner models.OwnerAndRepoName, number int) ([]*models.Commit, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		JOIN commit_pull_requests p ON p.host = c.host AND p.owner_name = c.owner_name AND p.repo_name = c.repo_name AND p.sha = c.sha
+		WHERE c.host = ? AND c.owner_name = ? AND c.repo_name = ? AND p.number = ?
+		ORDER BY o.commit_date, c.sha`, commitColumns, commitsFrom)
+
+	return s.queryCommits(ctx, query, owner.Host, owner.OwnerName, owner.RepoName, number)
+}
+
+func (s sqliteRepo) loadCommitPullRequests(ctx context.Context, commit *models.Commit) error {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT p.number, p.title, p.state, p.url, p.author, p.base_branch, p.head_branch, p.merged_at
+		FROM pull_requests p JOIN commit_pull_requests cp
+			ON cp.host = p.host AND cp.owner_name = p.owner_name AND cp.repo_name = p.repo_name AND cp.number = p.number
+		WHERE cp.host = ? AND cp.owner_name = ? AND cp.repo_name = ? AND cp.sha = ? ORDER BY p.number`,
+		commit.Host, commit.OwnerName, commit.RepoName, commit.SHA)
+	if err != nil {
+		return err
+	}
+
+	commit.PullRequests = make([]*models.PullRequest, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var pullRequest models.PullRequest
+		var mergedAt string
+		if err := rows.Scan(
+			&pullRequest.Number,
+			&pullRequest.Title,
+			&pullRequest.State,
+			&pullRequest.URL,
+			&pullRequest.Author,
+			&pullRequest.BaseBranch,
+			&pullRequest.HeadBranch,
+			&mergedAt,
+		); err != nil {
+			return err
+		}
+
+		if mergedAt != "" {
+			at, err := time.Parse(time.RFC3339, mergedAt)
+			if err != nil {
+				return err
+			}
+			pullRequest.MergedAt = &at
+		}
+
+		commit.PullRequests = append(commit.PullRequests, &pullRequest)
+	}
+
+	return rows.Err()
+}
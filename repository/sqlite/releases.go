@@ -0,0 +1,165 @@
+package sqlite
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+)
+
+// Tags and releases are mirrored as a whole, so both tables hold what the source had at the last sync.
+const releasesTableSetup = `
+CREATE TABLE IF NOT EXISTS tags (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		name TEXT,
+		sha TEXT,
+		UNIQUE (host, owner_name, repo_name, name)
+);
+
+CREATE TABLE IF NOT EXISTS releases (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		tag_name TEXT,
+		name TEXT,
+		body TEXT,
+		url TEXT,
+		author TEXT,
+		draft INTEGER,
+		prerelease INTEGER,
+		published_at TEXT NOT NULL DEFAULT '',
+		UNIQUE (host, owner_name, repo_name, tag_name)
+);
+`
+
+// SaveTags replaces the tags of a repository with tags.
+func (s sqliteRepo) SaveTags(ctx context.Context, owner models.OwnerAndRepoName, tags []*models.Tag) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM tags WHERE host = ? AND owner_name = ? AND repo_name = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if _, err = tx.ExecContext(ctx,
+			`INSERT OR REPLACE INTO tags (host, owner_name, repo_name, name, sha) VALUES (?, ?, ?, ?, ?)`,
+			owner.Host, owner.OwnerName, owner.RepoName, tag.Name, tag.SHA); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s sqliteRepo) GetTag(ctx context.Context, owner models.OwnerAndRepoName, name string) (*models.Tag, error) {
+	var tag models.Tag
+	err := s.dataStore.QueryRowContext(ctx,
+		`SELECT host, owner_name, repo_name, name, sha FROM tags WHERE host = ? AND owner_name = ? AND repo_name = ? AND name = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName, name).Scan(&tag.Host, &tag.OwnerName, &tag.RepoName, &tag.Name, &tag.SHA)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// SaveReleases replaces the releases of a repository with releases.
+func (s sqliteRepo) SaveReleases(ctx context.Context, owner models.OwnerAndRepoName, releases []*models.Release) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM releases WHERE host = ? AND owner_name = ? AND repo_name = ?`,
+		owner.Host, owner.OwnerName, owner.RepoName); err != nil {
+		return err
+	}
+
+	insertSQL := `
+        INSERT OR REPLACE INTO releases (
+			host,
+			owner_name,
+			repo_name,
+			tag_name,
+			name,
+			body,
+			url,
+			author,
+			draft,
+			prerelease,
+			published_at
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for _, release := range releases {
+		if _, err = tx.ExecContext(ctx, insertSQL,
+			owner.Host,
+			owner.OwnerName,
+			owner.RepoName,
+			release.TagName,
+			release.Name,
+			release.Body,
+			release.URL,
+			release.Author,
+			release.Draft,
+			release.Prerelease,
+			formatOptionalTime(release.PublishedAt),
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListReleases lists the releases of a repository with the commits of their tags, drafts first, then newest first.
+func (s sqliteRepo) ListReleases(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.Release, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT r.host, r.owner_name, r.repo_name, r.tag_name, COALESCE(t.sha, ''), r.name, r.body, r.url, r.author,
+			r.draft, r.prerelease, r.published_at
+		FROM releases r LEFT JOIN tags t
+			ON t.host = r.host AND t.owner_name = r.owner_name AND t.repo_name = r.repo_name AND t.name = r.tag_name
+		WHERE r.host = ? AND r.owner_name = ? AND r.repo_name = ?
+		ORDER BY r.published_at = '' DESC, r.published_at DESC, r.tag_name DESC`,
+		owner.Host, owner.OwnerName, owner.RepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.Release, 0)
+	defer rows.Close()
+	for rows.Next() {
+		var release models.Release
+		var publishedAt string
+		if err := rows.Scan(
+			&release.Host,
+			&release.OwnerName,
+			&release.RepoName,
+			&release.TagName,
+			&release.SHA,
+			&release.Name,
+			&release.Body,
+			&release.URL,
+			&release.Author,
+			&release.Draft,
+			&release.Prerelease,
+			&publishedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if release.PublishedAt, err = parseOptionalTime(publishedAt); err != nil {
+			return nil, err
+		}
+
+		list = append(list, &release)
+	}
+
+	return list, rows.Err()
+}
@@ -0,0 +1,220 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+// Repositories hold the metadata of every repository we mirror, as of their last sync.
+const repositoriesTableSetup = `
+CREATE TABLE IF NOT EXISTS repositories (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		default_branch TEXT,
+		visibility TEXT,
+		description TEXT,
+		topics TEXT,
+		stars INTEGER,
+		archived INTEGER,
+		pushed_at TEXT NOT NULL DEFAULT '',
+		synced_at DATETIME,
+		deleted_at TEXT NOT NULL DEFAULT '',
+		source_id INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (host, owner_name, repo_name)
+)
+`
+
+// renamedTables are the tables keyed by the name of a repository, which RenameRepository moves to its new name.
+var renamedTables = []string{
+	"repositories",
+	"commits",
+	"commit_branches",
+	"sync_cursors",
+	"backfill_checkpoints",
+	"history_rewrites",
+	"pull_requests",
+	"commit_pull_requests",
+	"tags",
+	"releases",
+	"commit_checks",
+	"commit_statuses",
+	"commit_check_runs",
+}
+
+func setupRepositoriesTable(db *sql.DB) error {
+	if _, err := db.Exec(repositoriesTableSetup); err != nil {
+		return err
+	}
+	return addColumnIfMissing(db, "repositories", "source_id", "INTEGER NOT NULL DEFAULT 0")
+}
+
+func scanRepository(row rowScanner) (*models.Repository, error) {
+	var repo models.Repository
+	var topics, pushedAt, syncedAt, deletedAt string
+	if err := row.Scan(
+		&repo.Host,
+		&repo.OwnerName,
+		&repo.RepoName,
+		&repo.DefaultBranch,
+		&repo.Visibility,
+		&repo.Description,
+		&topics,
+		&repo.Stars,
+		&repo.Archived,
+		&pushedAt,
+		&syncedAt,
+		&deletedAt,
+		&repo.SourceID,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(topics), &repo.Topics); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if repo.SyncedAt, err = time.Parse(time.RFC3339, syncedAt); err != nil {
+		return nil, err
+	}
+
+	if repo.PushedAt, err = parseOptionalTime(pushedAt); err != nil {
+		return nil, err
+	}
+
+	if repo.DeletedAt, err = parseOptionalTime(deletedAt); err != nil {
+		return nil, err
+	}
+
+	return &repo, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func formatOptionalTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+// SaveRepository stores the metadata of a repository, replacing what we had of it.
+func (s sqliteRepo) SaveRepository(ctx context.Context, repo *models.Repository) error {
+	topics := repo.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+
+	encodedTopics, err := json.Marshal(topics)
+	if err != nil {
+		return err
+	}
+
+	upsertSQL := `
+        INSERT INTO repositories (
+			host,
+			owner_name,
+			repo_name,
+			default_branch,
+			visibility,
+			description,
+			topics,
+			stars,
+			archived,
+			pushed_at,
+			synced_at,
+			deleted_at,
+			source_id
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (host, owner_name, repo_name) DO UPDATE SET
+			default_branch = excluded.default_branch,
+			visibility = excluded.visibility,
+			description = excluded.description,
+			topics = excluded.topics,
+			stars = excluded.stars,
+			archived = excluded.archived,
+			pushed_at = excluded.pushed_at,
+			synced_at = excluded.synced_at,
+			deleted_at = excluded.deleted_at,
+			source_id = excluded.source_id`
+
+	_, err = s.dataStore.ExecContext(ctx, upsertSQL,
+		repo.Host,
+		repo.OwnerName,
+		repo.RepoName,
+		repo.DefaultBranch,
+		repo.Visibility,
+		repo.Description,
+		string(encodedTopics),
+		repo.Stars,
+		repo.Archived,
+		formatOptionalTime(repo.PushedAt),
+		repo.SyncedAt.Format(time.RFC3339),
+		formatOptionalTime(repo.DeletedAt),
+		repo.SourceID,
+	)
+	return err
+}
+
+func (s sqliteRepo) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, error) {
+	row := s.dataStore.QueryRowContext(ctx,
+		`SELECT * FROM repositories WHERE host = ? AND owner_name = ? AND repo_name = ? LIMIT 1`,
+		owner.Host, owner.OwnerName, owner.RepoName)
+	return scanRepository(row)
+}
+
+// ListRepositories lists every repository we have mirrored, deleted ones included, ordered by host and name.
+func (s sqliteRepo) ListRepositories(ctx context.Context) ([]*models.Repository, error) {
+	rows, err := s.dataStore.QueryContext(ctx, `SELECT * FROM repositories ORDER BY host, owner_name, repo_name`)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.Repository, 0)
+	defer rows.Close()
+	for rows.Next() {
+		repo, err := scanRepository(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, repo)
+	}
+
+	return list, rows.Err()
+}
+
+// RenameRepository moves everything stored of a repository to its new name in one transaction. Rows already stored
+// under the new name, e.g. by a sync that ran into the rename first, are replaced by those of the old name.
+func (s sqliteRepo) RenameRepository(ctx context.Context, from, to models.OwnerAndRepoName) error {
+	tx, err := s.dataStore.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range renamedTables {
+		if _, err = tx.ExecContext(ctx,
+			fmt.Sprintf(`UPDATE OR REPLACE %s SET host = ?, owner_name = ?, repo_name = ? WHERE host = ? AND owner_name = ? AND repo_name = ?`, table),
+			to.Host, to.OwnerName, to.RepoName, from.Host, from.OwnerName, from.RepoName); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+)
+
+func (s sqliteRepo) GetSignatureReport(ctx context.Context, filter models.CommitFilters) (*models.SignatureReport, error) {
+	where, args := commitFilterClause(filter)
+	query := fmt.Sprintf(`SELECT o.verification_reason, o.verified, COUNT(*) FROM %s WHERE %s
+		GROUP BY o.verification_reason, o.verified ORDER BY COUNT(*) DESC`, commitsFrom, where)
+
+	rows, err := s.dataStore.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.SignatureReport{
+		OwnerName: filter.OwnerName,
+		RepoName:  filter.RepoName,
+		Reasons:   make([]*models.VerificationReasonCount, 0),
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var reason string
+		var verified bool
+		var count int
+		if err := rows.Scan(&reason, &verified, &count); err != nil {
+			return nil, err
+		}
+
+		report.TotalCommits += count
+		switch {
+		case reason == "":
+			report.UnknownCommits += count
+			continue
+		case verified:
+			report.VerifiedCommits += count
+		case reason == models.VerificationUnsigned:
+			report.UnsignedCommits += count
+		default:
+			report.UnverifiedSigned += count
+		}
+
+		report.Reasons = append(report.Reasons, &models.VerificationReasonCount{Reason: reason, Count: count})
+	}
+
+	if known := report.TotalCommits - report.UnknownCommits; known > 0 {
+		report.SignedRatio = float64(report.VerifiedCommits) / float64(known)
+	}
+
+	return report, rows.Err()
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"database/sql"
 	"gitbeam.commit.monitor/repository"
+	"sync"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -11,6 +12,7 @@ import (
 // But for this exercise, without too many dependencies I'm using the native go sql driver on sqlite db.
 type sqliteRepo struct {
 	dataStore *sql.DB
+	reparsing *sync.WaitGroup // Done once the messages of commits stored by older versions have been parsed.
 }
 
 func NewSqliteRepo(dbName string) (repository.DataStore, error) {
@@ -19,13 +21,71 @@ func NewSqliteRepo(dbName string) (repository.DataStore, error) {
 		return nil, err
 	}
 
-	if _, err := db.Exec(commitsTableSetup); err != nil {
+	if err := setupCommitsTables(db); err != nil {
 		return nil, err
 	}
-	if _, err := db.Exec(cronTrackerTableSetup); err != nil {
+	if _, err := db.Exec(commitFilesTableSetup); err != nil {
 		return nil, err
 	}
-	return &sqliteRepo{
+	if err := setupCommitTrailersTable(db); err != nil {
+		return nil, err
+	}
+	if err := setupConventionalCommitColumns(db); err != nil {
+		return nil, err
+	}
+	if err := setupCronTrackerTable(db); err != nil {
+		return nil, err
+	}
+	if err := setupCommitBranchesTable(db); err != nil {
+		return nil, err
+	}
+	if err := setupSyncCursorsTable(db); err != nil {
+		return nil, err
+	}
+	if err := setupBackfillCheckpointsTable(db); err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(authorAliasesTableSetup); err != nil {
+		return nil, err
+	}
+	if err := setupHistoryRewritesTable(db); err != nil {
+		return nil, err
+	}
+	if err := setupPullRequestsTables(db); err != nil {
+		return nil, err
+	}
+	if err := setupRepositoriesTable(db); err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(releasesTableSetup); err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(commitChecksTableSetup); err != nil {
+		return nil, err
+	}
+
+	repo := &sqliteRepo{
 		dataStore: db,
-	}, nil
+		reparsing: &sync.WaitGroup{},
+	}
+
+	// Only started when there is something to parse, the check is cheap next to parsing.
+	pending, err := hasUnparsedMessages(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending {
+		repo.reparsing.Add(1)
+		go func() {
+			defer repo.reparsing.Done()
+			for _, flag := range reparseFlags {
+				if err := reparseCommitMessages(db, flag.column, flag.parse); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	return repo, nil
 }
@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gitbeam.commit.monitor/repository"
+)
+
+// memoryDatabase names a shared in-memory database private to the test, which every connection of the pool sees.
+func memoryDatabase(t *testing.T) string {
+	return fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "_"))
+}
+
+func newTestDataStore(t *testing.T) repository.DataStore {
+	t.Helper()
+	dataStore, err := NewSqliteRepo(memoryDatabase(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = dataStore.(*sqliteRepo).dataStore.Close() })
+	return dataStore
+}
@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"gitbeam.commit.monitor/models"
+	"time"
+)
+
+const syncCursorsTableSetup = `
+CREATE TABLE IF NOT EXISTS sync_cursors (
+		host TEXT NOT NULL DEFAULT '',
+		owner_name TEXT,
+		repo_name TEXT,
+		branch TEXT,
+		head_sha TEXT,
+		new_commits INTEGER,
+		last_synced_at DATETIME,
+		UNIQUE (host, owner_name, repo_name, branch)
+)
+`
+
+func setupSyncCursorsTable(db *sql.DB) error {
+	if err := migrateToHostKey(db, "sync_cursors", syncCursorsTableSetup); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(syncCursorsTableSetup)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSyncCursor(row rowScanner) (*models.SyncCursor, error) {
+	var cursor models.SyncCursor
+	var lastSyncedAt string
+	if err := row.Scan(
+		&cursor.Host,
+		&cursor.OwnerName,
+		&cursor.RepoName,
+		&cursor.Branch,
+		&cursor.HeadSHA,
+		&cursor.NewCommits,
+		&lastSyncedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if cursor.LastSyncedAt, err = time.Parse(time.RFC3339, lastSyncedAt); err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
+
+func (s sqliteRepo) GetSyncCursor(ctx context.Context, owner models.OwnerAndRepoName, branch string) (*models.SyncCursor, error) {
+	row := s.dataStore.QueryRowContext(ctx,
+		`SELECT * FROM sync_cursors WHERE host = ? AND owner_name = ? AND repo_name = ? AND branch = ? LIMIT 1`,
+		owner.Host, owner.OwnerName, owner.RepoName, branch)
+	return scanSyncCursor(row)
+}
+
+func (s sqliteRepo) ListSyncCursors(ctx context.Context, owner models.OwnerAndRepoName) ([]*models.SyncCursor, error) {
+	rows, err := s.dataStore.QueryContext(ctx,
+		`SELECT * FROM sync_cursors WHERE host = ? AND owner_name = ? AND repo_name = ? ORDER BY branch`,
+		owner.Host, owner.OwnerName, owner.RepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*models.SyncCursor
+	defer rows.Close()
+	for rows.Next() {
+		cursor, err := scanSyncCursor(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, cursor)
+	}
+
+	return list, nil
+}
+
+func (s sqliteRepo) SaveSyncCursor(ctx context.Context, cursor *models.SyncCursor) error {
+	upsertSQL := `
+        INSERT INTO sync_cursors (
+			host,
+			owner_name,
+			repo_name,
+			branch,
+			head_sha,
+			new_commits,
+			last_synced_at
+		)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (host, owner_name, repo_name, branch) DO UPDATE SET
+			head_sha = excluded.head_sha,
+			new_commits = excluded.new_commits,
+			last_synced_at = excluded.last_synced_at`
+
+	_, err := s.dataStore.ExecContext(ctx, upsertSQL,
+		cursor.Host,
+		cursor.OwnerName,
+		cursor.RepoName,
+		cursor.Branch,
+		cursor.HeadSHA,
+		cursor.NewCommits,
+		cursor.LastSyncedAt.Format(time.RFC3339),
+	)
+	return err
+}
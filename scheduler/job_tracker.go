@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"gitbeam.commit.monitor/core"
 	"gitbeam.commit.monitor/models"
@@ -15,15 +16,22 @@ type Job struct {
 	Config *models.MonitorRepositoryCommitConfig
 }
 
-func newJob(coreService *core.GitBeamService, cfg *models.MonitorRepositoryCommitConfig) Job {
+// newJob creates the job syncing the repository of cfg, which reports the outcome of every run to recordRun.
+// The repository as refreshed from its source is handed to trackRepository, which returns the config to sync it by.
+func newJob(
+	coreService *core.GitBeamService,
+	cfg *models.MonitorRepositoryCommitConfig,
+	recordRun func(cfg *models.MonitorRepositoryCommitConfig, err error),
+	trackRepository func(cfg *models.MonitorRepositoryCommitConfig, repo *models.Repository) (*models.MonitorRepositoryCommitConfig, error),
+) Job {
 	return Job{
 		Config: cfg,
 		Task: func(withDateRange bool) {
+			var err error
+			defer func() { recordRun(cfg, err) }()
+
 			ctx := context.Background()
-			name := models.OwnerAndRepoName{
-				OwnerName: cfg.OwnerName,
-				RepoName:  cfg.RepoName,
-			}
+			name := cfg.OwnerAndRepoName()
 
 			filters := models.CommitFilters{
 				OwnerAndRepoName: name,
@@ -31,32 +39,67 @@ func newJob(coreService *core.GitBeamService, cfg *models.MonitorRepositoryCommi
 				ToDate:           nil,
 				Limit:            0,
 				Page:             0,
+				Fetcher:          cfg.Fetcher,
 			}
 
-			if withDateRange {
-				if cfg.FromDate != "" {
-					if date, _ := models.ParseDate(cfg.FromDate); date != nil {
-						filters.FromDate = date
-					}
+			if cfg.FromDate != "" {
+				if date, _ := models.ParseDate(cfg.FromDate); date != nil {
+					filters.FromDate = date
 				}
+			}
 
-				if cfg.ToDate != "" {
-					if date, _ := models.ParseDate(cfg.ToDate); date != nil {
-						filters.ToDate = date
+			if !withDateRange {
+				// Finish interrupted backfills before picking up what was pushed since the last sync.
+				for _, checkpoint := range coreService.GetPendingBackfills(ctx, name) {
+					checkpointFilters := checkpoint.Filters()
+					checkpointFilters.Fetcher = cfg.Fetcher
+					if err = coreService.FetchAndSaveCommits(ctx, checkpointFilters); err != nil {
+						return
 					}
 				}
-			} else {
-				filters.ToDate, _ = models.ParseDate(time.Now().Format(time.DateOnly))
-				if lastCommit, _ := coreService.GetLastCommit(ctx, name); lastCommit != nil {
-					filters.FromDate, _ = models.ParseDate(lastCommit.Date.Format(time.DateOnly))
-					filters.ToDate, _ = models.ParseDate(time.Now().Format(time.DateOnly))
+			}
+
+			if withDateRange && cfg.ToDate != "" {
+				if date, _ := models.ParseDate(cfg.ToDate); date != nil {
+					filters.ToDate = date
+				}
+			}
+
+			repo, err := coreService.RefreshRepository(ctx, name, cfg.SourceID)
+			if err != nil {
+				return
+			}
+
+			// The repository may have been renamed or transferred, this run goes on under its new name.
+			if cfg, err = trackRepository(cfg, repo); err != nil {
+				return
+			}
+			name = cfg.OwnerAndRepoName()
+			filters.OwnerAndRepoName = name
+
+			branches, err := coreService.ResolveBranches(ctx, name, cfg.Branches)
+			if err != nil {
+				return
+			}
+
+			// A failing branch doesn't keep the others from syncing.
+			branchErrs := make([]error, 0)
+			for _, branch := range branches {
+				filters.Branch = branch
+				if withDateRange {
+					branchErrs = append(branchErrs, coreService.FetchAndSaveCommits(ctx, filters))
 				} else {
-					filters.FromDate = nil
-					filters.ToDate = nil
+					// Routine runs only pick up what was pushed since the last sync.
+					_, syncErr := coreService.SyncCommits(ctx, filters)
+					branchErrs = append(branchErrs, syncErr)
 				}
 			}
 
-			_ = coreService.FetchAndSaveCommits(context.Background(), filters)
+			// Tags are mirrored after the branches, so the commits they point at are mirrored by then.
+			err = errors.Join(append(branchErrs,
+				coreService.SyncReleases(ctx, name),
+				coreService.RefreshPendingChecks(ctx, name),
+			)...)
 		},
 	}
 }
@@ -71,20 +114,25 @@ type jobTracker struct {
 	mu        sync.Mutex
 }
 
-// addJob adds a new job to the scheduler
-func (s *jobTracker) addJob(job Job) {
+// addJob adds a new job to the scheduler, which runs it every polling interval of its config.
+func (s *jobTracker) addJob(job Job) error {
+	if interval := job.Config.PollingInterval(); interval <= 0 {
+		return fmt.Errorf("%w: job %s polls every %s", ErrInvalidPollingInterval, job.ID(), interval)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if _, exists := s.jobs[job.ID()]; exists {
-		fmt.Printf("Job with ID %s already exists.\n", job.ID)
-		return
+		fmt.Printf("Job with ID %s already exists.\n", job.ID())
+		return nil
 	}
 
 	s.jobs[job.ID()] = &job
 	stopChan := make(chan bool)
 	s.stopChans[job.ID()] = stopChan
 	go s.startJob(&job, stopChan)
+	return nil
 }
 
 // removeJob removes a job from the scheduler
@@ -101,7 +149,7 @@ func (s *jobTracker) removeJob(id string) {
 }
 
 // updateJob updates an existing job's interval
-func (s *jobTracker) updateJob(cfg models.MonitorRepositoryCommitConfig) {
+func (s *jobTracker) updateJob(cfg models.MonitorRepositoryCommitConfig) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -109,20 +157,21 @@ func (s *jobTracker) updateJob(cfg models.MonitorRepositoryCommitConfig) {
 
 	if job, exists := s.jobs[id]; exists {
 		s.removeJob(id)
-		s.addJob(*job)
+		return s.addJob(*job)
 	}
+	return nil
 }
 
 // startJob runs the job at specified intervals
 func (s *jobTracker) startJob(job *Job, stopChan chan bool) {
-	ticker := time.NewTicker(60 * time.Minute * time.Duration(job.Config.DurationInHours))
+	ticker := time.NewTicker(job.Config.PollingInterval())
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
 			job.Task(false)
 		case <-stopChan:
-			fmt.Printf("Stopping job %s\n", job.ID)
+			fmt.Printf("Stopping job %s\n", job.ID())
 			return
 		}
 	}
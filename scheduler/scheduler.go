@@ -8,13 +8,16 @@ import (
 	"gitbeam.commit.monitor/events/topics"
 	"gitbeam.commit.monitor/models"
 	"gitbeam.commit.monitor/repository"
+	"gitbeam.commit.monitor/source"
 	"github.com/sirupsen/logrus"
 	"sync"
+	"time"
 )
 
 var (
 	ErrFailedToStartMonitoringRepoCommits = errors.New("failed to start monitoring repo commits")
 	ErrFailedToStopMonitoringRepoCommits  = errors.New("failed to stop monitoring repo commits")
+	ErrInvalidPollingInterval             = errors.New("polling interval must be positive")
 )
 
 // Scheduler manages the scheduling of jobs
@@ -41,11 +44,14 @@ func NewScheduler(coreService *core.GitBeamService, dataStore repository.CronSer
 func (s *Scheduler) StartMirroringRepoCommits(ctx context.Context, payload models.MonitorRepositoryCommitConfig) error {
 	useLogger := s.logger.WithContext(ctx).WithField("methodName", "StartMirroringRepoCommits")
 
-	name := models.OwnerAndRepoName{
-		OwnerName: payload.OwnerName,
-		RepoName:  payload.RepoName,
+	var err error
+	if payload.Host, payload.Provider, err = s.coreService.ResolveSourceHost(payload.Provider, payload.Host); err != nil {
+		useLogger.WithError(err).Error("Failed to resolve the host of the repository.")
+		return err
 	}
 
+	name := payload.OwnerAndRepoName()
+
 	existingConfig, _ := s.dataStore.GetMonitorConfig(ctx, name)
 	if existingConfig != nil {
 		err := s.dataStore.DeleteMonitorConfig(ctx, name)
@@ -56,14 +62,18 @@ func (s *Scheduler) StartMirroringRepoCommits(ctx context.Context, payload model
 		s.jobTracker.removeJob(existingConfig.ID())
 	}
 
+	job := newJob(s.coreService, &payload, s.recordRun, s.trackRepository)
+	if err := s.jobTracker.addJob(job); err != nil {
+		useLogger.WithError(err).Error("Failed to schedule cron task.")
+		return err
+	}
+
 	if err := s.dataStore.SaveMonitorConfigs(ctx, payload); err != nil {
+		s.jobTracker.removeJob(job.ID())
 		useLogger.WithError(err).Error("Failed to save cron task in cronStore.")
 		return ErrFailedToStartMonitoringRepoCommits
 	}
 
-	job := newJob(s.coreService, &payload)
-	s.jobTracker.addJob(job)
-
 	eventStore := s.coreService.GetEventStore()
 
 	data, _ := json.Marshal(payload)
@@ -94,6 +104,129 @@ func (s *Scheduler) StopMirroringRepoCommits(ctx context.Context, name models.Ow
 	return nil
 }
 
+// GetMonitorConfig returns how the repository is monitored, nil when it isn't.
+func (s *Scheduler) GetMonitorConfig(ctx context.Context, name models.OwnerAndRepoName) *models.MonitorRepositoryCommitConfig {
+	config, _ := s.dataStore.GetMonitorConfig(ctx, name)
+	return config
+}
+
+// GetMonitorConfigBySourceID returns how the repository with the given source ID is monitored, nil when it isn't.
+func (s *Scheduler) GetMonitorConfigBySourceID(ctx context.Context, host string, sourceID int64) *models.MonitorRepositoryCommitConfig {
+	config, _ := s.dataStore.GetMonitorConfigBySourceID(ctx, host, sourceID)
+	return config
+}
+
+// FollowRepository refreshes the repository of cfg, moving it and its monitor to its new name when it was renamed
+// or transferred. It returns the config the repository is monitored by from then on.
+func (s *Scheduler) FollowRepository(ctx context.Context, cfg *models.MonitorRepositoryCommitConfig) (*models.MonitorRepositoryCommitConfig, error) {
+	repo, err := s.coreService.RefreshRepository(ctx, cfg.OwnerAndRepoName(), cfg.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.trackRepository(cfg, repo)
+}
+
+// recordRun stores the outcome of a scheduled sync of the repository of cfg as the status of its monitor.
+func (s *Scheduler) recordRun(cfg *models.MonitorRepositoryCommitConfig, err error) {
+	useLogger := s.logger.WithField("methodName", "recordRun").WithField("repository", cfg.ID())
+
+	status := *cfg
+	status.Status, status.LastError, status.LastErrorAt = models.MonitorHealthy, "", nil
+	if err != nil {
+		now := time.Now()
+		status.Status, status.LastError, status.LastErrorAt = models.MonitorDegraded, err.Error(), &now
+		if errors.Is(err, source.ErrNotFound) || errors.Is(err, source.ErrAccessDenied) {
+			status.Status = models.MonitorFailed
+		}
+
+		if errors.Is(err, core.ErrSourceCircuitOpen) {
+			// The outage is logged once by the core service, not by every job it cut short.
+			useLogger.WithError(err).Debug("scheduled sync skipped while the source host is failing")
+		} else {
+			useLogger.WithError(err).WithField("status", status.Status).Error("scheduled sync failed")
+		}
+	}
+
+	if err := s.dataStore.SaveMonitorStatus(context.Background(), &status); err != nil {
+		useLogger.WithError(err).Error("Failed to save monitor status in cronStore.")
+	}
+}
+
+// trackRepository keeps the monitor of cfg on the repository as refreshed from its source, recording its source ID
+// and moving the repository, its monitor and its job to the new name of a renamed or transferred repository.
+// It returns the config the repository is monitored by from then on.
+//
+// Stored commits and the monitor live in separate databases. The source ID is recorded on the monitor before
+// anything moves, so that when a step fails the next run still finds the repository by it and picks up from there.
+func (s *Scheduler) trackRepository(cfg *models.MonitorRepositoryCommitConfig, repo *models.Repository) (*models.MonitorRepositoryCommitConfig, error) {
+	from := cfg.OwnerAndRepoName()
+	if from == repo.OwnerAndRepoName() && cfg.SourceID == repo.SourceID {
+		return cfg, nil
+	}
+
+	ctx := context.Background()
+	useLogger := s.logger.WithField("methodName", "trackRepository").WithField("repository", cfg.ID())
+
+	tracked := *cfg
+	if tracked.SourceID != repo.SourceID {
+		tracked.SourceID = repo.SourceID
+		if err := s.dataStore.RenameMonitorConfig(ctx, from, &tracked); err != nil {
+			useLogger.WithError(err).Error("Failed to save the source ID of the repository in cronStore.")
+			return cfg, err
+		}
+	}
+
+	if from == repo.OwnerAndRepoName() {
+		return &tracked, s.replaceJob(cfg, &tracked)
+	}
+
+	if err := s.coreService.RenameRepository(ctx, from, repo); err != nil {
+		return &tracked, err
+	}
+
+	useLogger = useLogger.WithFields(logrus.Fields{
+		"newOwnerName": repo.OwnerName,
+		"newRepoName":  repo.RepoName,
+	})
+	if existingConfig, _ := s.dataStore.GetMonitorConfig(ctx, repo.OwnerAndRepoName()); existingConfig != nil {
+		// The repository is monitored under its new name already, which leaves the old monitor redundant.
+		if err := s.dataStore.DeleteMonitorConfig(ctx, from); err != nil {
+			useLogger.WithError(err).Error("Failed to delete cron task from cronStore.")
+			return &tracked, err
+		}
+		if existingConfig.ID() != cfg.ID() {
+			s.jobTracker.removeJob(cfg.ID())
+		}
+		useLogger.Info("dropped monitor of renamed repository, it is monitored under its new name")
+		return existingConfig, nil
+	}
+
+	renamed := tracked
+	renamed.Host, renamed.OwnerName, renamed.RepoName = repo.Host, repo.OwnerName, repo.RepoName
+	if err := s.dataStore.RenameMonitorConfig(ctx, from, &renamed); err != nil {
+		useLogger.WithError(err).Error("Failed to rename cron task in cronStore.")
+		return &tracked, err
+	}
+
+	useLogger.Info("moved monitor to the new name of the repository")
+	return &renamed, s.replaceJob(cfg, &renamed)
+}
+
+// replaceJob moves the job of cfg under the ID of updated when it differs, which only happens once its source ID is
+// recorded. The job running now stops once done, its successor syncs the repository as updated.
+func (s *Scheduler) replaceJob(cfg, updated *models.MonitorRepositoryCommitConfig) error {
+	if updated.ID() == cfg.ID() {
+		return nil
+	}
+
+	s.jobTracker.removeJob(cfg.ID())
+	if err := s.jobTracker.addJob(newJob(s.coreService, updated, s.recordRun, s.trackRepository)); err != nil {
+		s.logger.WithField("methodName", "replaceJob").WithError(err).Error("Failed to schedule cron task.")
+		return err
+	}
+	return nil
+}
+
 func (s *Scheduler) StartScheduler() {
 	s.loadExistingConfig()
 	s.logger.Info("Started commit monitor scheduler...")
@@ -110,12 +243,17 @@ func (s *Scheduler) loadExistingConfig() {
 	wg := sync.WaitGroup{}
 	for _, config := range list {
 		wg.Add(1)
-		job := newJob(s.coreService, config)
+		job := newJob(s.coreService, config, s.recordRun, s.trackRepository)
+		// Tracked before its first run, which may move it to the new name of its repository.
+		if err := s.jobTracker.addJob(job); err != nil {
+			s.logger.WithField("methodName", "loadExistingConfig").WithError(err).Error("Failed to schedule cron task.")
+			wg.Done()
+			continue
+		}
 		go func(job *Job) {
 			defer wg.Done()
 			job.Task(false)
 		}(&job)
-		s.jobTracker.addJob(job)
 	}
 
 	wg.Wait()
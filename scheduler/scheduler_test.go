@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"gitbeam.baselib/store"
+	"gitbeam.commit.monitor/core"
+	"gitbeam.commit.monitor/mocks"
+	"gitbeam.commit.monitor/models"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestScheduler(t *testing.T, dataStore *mocks.MockDataStore, cronStore *mocks.MockCronServiceStore) *Scheduler {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	coreService, err := core.NewGitBeamService(logger, store.NewEventStore(logger), dataStore, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheduler := NewScheduler(coreService, cronStore, logger)
+	t.Cleanup(func() {
+		// Stop the jobs the test started.
+		scheduler.jobTracker.mu.Lock()
+		ids := make([]string, 0, len(scheduler.jobTracker.jobs))
+		for id := range scheduler.jobTracker.jobs {
+			ids = append(ids, id)
+		}
+		scheduler.jobTracker.mu.Unlock()
+
+		for _, id := range ids {
+			scheduler.jobTracker.removeJob(id)
+		}
+	})
+	return scheduler
+}
+
+func TestTrackRepositoryRecordsSourceIDFirst(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	cronStore := mocks.NewMockCronServiceStore(ctrl)
+	scheduler := newTestScheduler(t, dataStore, cronStore)
+
+	ctx := gomock.Any()
+	from := models.OwnerAndRepoName{Host: "github", OwnerName: "o", RepoName: "r"}
+	to := models.OwnerAndRepoName{Host: "github", OwnerName: "o2", RepoName: "r2"}
+	repo := &models.Repository{Host: "github", OwnerName: "o2", RepoName: "r2", SourceID: 7}
+	cfg := &models.MonitorRepositoryCommitConfig{Host: "github", OwnerName: "o", RepoName: "r", DurationInHours: 1}
+	failure := errors.New("cron store unavailable")
+
+	gomock.InOrder(
+		cronStore.EXPECT().RenameMonitorConfig(ctx, from, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ models.OwnerAndRepoName, tracked *models.MonitorRepositoryCommitConfig) error {
+				if tracked.OwnerAndRepoName() != from || tracked.SourceID != 7 {
+					t.Errorf("RenameMonitorConfig() got %+v, want the source ID recorded under the old name", tracked)
+				}
+				return nil
+			}),
+		dataStore.EXPECT().GetRepository(ctx, from).Return(&models.Repository{}, nil),
+		dataStore.EXPECT().RenameRepository(ctx, from, to).Return(nil),
+		dataStore.EXPECT().GetRepository(ctx, to).Return(&models.Repository{}, nil),
+		dataStore.EXPECT().SaveRepository(ctx, repo).Return(nil),
+		cronStore.EXPECT().GetMonitorConfig(ctx, to).Return(nil, errors.New("not found")),
+		cronStore.EXPECT().RenameMonitorConfig(ctx, from, gomock.Any()).Return(failure),
+	)
+
+	tracked, err := scheduler.trackRepository(cfg, repo)
+	if !errors.Is(err, failure) {
+		t.Fatalf("trackRepository() error = %v, want %v", err, failure)
+	}
+	if tracked.OwnerAndRepoName() != from || tracked.SourceID != 7 {
+		t.Errorf("trackRepository() = %+v, want the monitor under its old name with source ID 7", tracked)
+	}
+}
+
+func TestTrackRepositoryRecordsSourceID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	cronStore := mocks.NewMockCronServiceStore(ctrl)
+	scheduler := newTestScheduler(t, dataStore, cronStore)
+
+	name := models.OwnerAndRepoName{Host: "github", OwnerName: "o", RepoName: "r"}
+	repo := &models.Repository{Host: "github", OwnerName: "o", RepoName: "r", SourceID: 7}
+	cfg := &models.MonitorRepositoryCommitConfig{Host: "github", OwnerName: "o", RepoName: "r", DurationInHours: 1}
+
+	cronStore.EXPECT().RenameMonitorConfig(gomock.Any(), name, gomock.Any()).Return(nil)
+
+	tracked, err := scheduler.trackRepository(cfg, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracked.OwnerAndRepoName() != name || tracked.SourceID != 7 {
+		t.Errorf("trackRepository() = %+v, want source ID 7 recorded", tracked)
+	}
+
+	// Nothing is left to record once the monitor is up to date.
+	if again, err := scheduler.trackRepository(tracked, repo); err != nil || again != tracked {
+		t.Errorf("trackRepository() = %+v, %v, want the config unchanged", again, err)
+	}
+}
+
+func TestTrackRepositoryKeepsJobOnRename(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mocks.NewMockDataStore(ctrl)
+	cronStore := mocks.NewMockCronServiceStore(ctrl)
+	scheduler := newTestScheduler(t, dataStore, cronStore)
+
+	ctx := gomock.Any()
+	from := models.OwnerAndRepoName{Host: "github", OwnerName: "o", RepoName: "r"}
+	to := models.OwnerAndRepoName{Host: "github", OwnerName: "o2", RepoName: "r2"}
+	repo := &models.Repository{Host: "github", OwnerName: "o2", RepoName: "r2", SourceID: 7}
+	cfg := &models.MonitorRepositoryCommitConfig{Host: "github", OwnerName: "o", RepoName: "r", SourceID: 7, DurationInHours: 1}
+
+	if err := scheduler.jobTracker.addJob(newJob(scheduler.coreService, cfg, scheduler.recordRun, scheduler.trackRepository)); err != nil {
+		t.Fatal(err)
+	}
+	job := scheduler.jobTracker.jobs[cfg.ID()]
+
+	dataStore.EXPECT().GetRepository(ctx, from).Return(&models.Repository{}, nil)
+	dataStore.EXPECT().RenameRepository(ctx, from, to).Return(nil)
+	dataStore.EXPECT().GetRepository(ctx, to).Return(&models.Repository{}, nil)
+	dataStore.EXPECT().SaveRepository(ctx, repo).Return(nil)
+	cronStore.EXPECT().GetMonitorConfig(ctx, to).Return(nil, errors.New("not found"))
+	cronStore.EXPECT().RenameMonitorConfig(ctx, from, gomock.Any()).Return(nil)
+
+	tracked, err := scheduler.trackRepository(cfg, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracked.OwnerAndRepoName() != to || tracked.ID() != cfg.ID() {
+		t.Errorf("trackRepository() = %+v, want it under %v with ID %v", tracked, to, cfg.ID())
+	}
+	if len(scheduler.jobTracker.jobs) != 1 || scheduler.jobTracker.jobs[cfg.ID()] != job {
+		t.Errorf("jobs = %v, want the job of the repository kept", scheduler.jobTracker.jobs)
+	}
+}
+
+func TestAddJobRejectsNonPositiveInterval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	scheduler := newTestScheduler(t, mocks.NewMockDataStore(ctrl), mocks.NewMockCronServiceStore(ctrl))
+
+	cfg := &models.MonitorRepositoryCommitConfig{Host: "github", OwnerName: "o", RepoName: "r"}
+	err := scheduler.jobTracker.addJob(newJob(scheduler.coreService, cfg, scheduler.recordRun, scheduler.trackRepository))
+	if !errors.Is(err, ErrInvalidPollingInterval) {
+		t.Fatalf("addJob() error = %v, want %v", err, ErrInvalidPollingInterval)
+	}
+	if len(scheduler.jobTracker.jobs) != 0 {
+		t.Errorf("jobs = %v, want none", scheduler.jobTracker.jobs)
+	}
+}
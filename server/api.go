@@ -18,9 +18,14 @@ type apiService struct {
 
 func (a apiService) StartMonitoringRepositoryCommits(ctx context.Context, params *commits.MonitorRepositoryCommitsConfigParams) (*commits.Void, error) {
 	payload := models.MonitorRepositoryCommitConfig{
+		Host:            params.Host,
+		Provider:        params.Provider,
 		OwnerName:       params.OwnerName,
 		RepoName:        params.RepoName,
 		DurationInHours: params.DurationInHours,
+		Branches:        params.Branches,
+		WebhookSecret:   params.WebhookSecret,
+		Fetcher:         params.Fetcher,
 		FromDate:        "",
 		ToDate:          "",
 	}
@@ -43,6 +48,7 @@ func (a apiService) StartMonitoringRepositoryCommits(ctx context.Context, params
 
 func (a apiService) StopMonitoringRepositoryCommits(ctx context.Context, params *commits.StopMonitoringRepositoryCommitParams) (*commits.Void, error) {
 	err := a.schedulerService.StopMirroringRepoCommits(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
 		OwnerName: params.OwnerName,
 		RepoName:  params.RepoName,
 	})
@@ -50,26 +56,7 @@ func (a apiService) StopMonitoringRepositoryCommits(ctx context.Context, params
 }
 
 func (a apiService) ListCommits(ctx context.Context, params *commits.CommitFilterParams) (*commits.ListCommitResponse, error) {
-	filter := models.CommitFilters{
-		OwnerAndRepoName: models.OwnerAndRepoName{
-			OwnerName: params.OwnerName,
-			RepoName:  params.RepoName,
-		},
-		Limit:    params.Limit,
-		Page:     params.Page,
-		FromDate: nil,
-		ToDate:   nil,
-	}
-
-	if params.FromDate != "" {
-		filter.FromDate, _ = models.ParseDate(params.FromDate) // This will be nil if the date format doesn't work out.
-	}
-
-	if params.ToDate != "" {
-		filter.ToDate, _ = models.ParseDate(params.ToDate) // This will be nil if the date format doesn't work out.
-	}
-
-	output, err := a.service.ListCommits(ctx, filter)
+	output, err := a.service.ListCommits(ctx, toCommitFilters(params))
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +68,7 @@ func (a apiService) ListCommits(ctx context.Context, params *commits.CommitFilte
 
 func (a apiService) GetCommitByOwnerAndSHA(ctx context.Context, params *commits.CommitByOwnerAndShaParams) (*commits.Commit, error) {
 	output, err := a.service.GetCommitsBySha(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
 		OwnerName: params.OwnerName,
 		RepoName:  params.RepoName,
 	}, params.Sha)
@@ -93,38 +81,273 @@ func (a apiService) GetCommitByOwnerAndSHA(ctx context.Context, params *commits.
 	return &c, nil
 }
 
+func (a apiService) GetCommitFiles(ctx context.Context, params *commits.CommitByOwnerAndShaParams) (*commits.ListCommitFilesResponse, error) {
+	output, err := a.service.GetCommitFiles(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	}, params.Sha)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.CommitFile
+	_ = utils.UnPack(output, &list)
+	return &commits.ListCommitFilesResponse{Data: list}, nil
+}
+
+func (a apiService) GetCommitChecks(ctx context.Context, params *commits.CommitByOwnerAndShaParams) (*commits.CommitChecks, error) {
+	output, err := a.service.GetCommitChecks(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	}, params.Sha)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks commits.CommitChecks
+	_ = utils.UnPack(output, &checks)
+	return &checks, nil
+}
+
 func (a apiService) ListTopCommitAuthor(ctx context.Context, params *commits.CommitFilterParams) (*commits.ListTopCommitAuthorResponse, error) {
-	filter := models.CommitFilters{
+	output, err := a.service.GetTopCommitAuthors(ctx, toCommitFilters(params))
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.TopCommitAuthor
+	_ = utils.UnPack(output, &list)
+	return &commits.ListTopCommitAuthorResponse{Data: list}, nil
+}
+
+func (a apiService) GetSignatureReport(ctx context.Context, params *commits.CommitFilterParams) (*commits.SignatureReport, error) {
+	output, err := a.service.GetSignatureReport(ctx, toCommitFilters(params))
+	if err != nil {
+		return nil, err
+	}
+
+	var report commits.SignatureReport
+	_ = utils.UnPack(output, &report)
+	return &report, nil
+}
+
+func (a apiService) GetRepositorySyncStatus(ctx context.Context, params *commits.RepositoryParams) (*commits.SyncStatusResponse, error) {
+	name := models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	}
+
+	output, err := a.service.GetSyncStatus(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if config := a.schedulerService.GetMonitorConfig(ctx, name); config != nil {
+		output.MonitorStatus, output.LastError, output.LastErrorAt = config.Status, config.LastError, config.LastErrorAt
+	}
+
+	var response commits.SyncStatusResponse
+	_ = utils.UnPack(output, &response)
+	return &response, nil
+}
+
+func (a apiService) ListHistoryRewrites(ctx context.Context, params *commits.RepositoryParams) (*commits.ListHistoryRewritesResponse, error) {
+	output, err := a.service.ListHistoryRewrites(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.HistoryRewrite
+	_ = utils.UnPack(output, &list)
+	return &commits.ListHistoryRewritesResponse{Data: list}, nil
+}
+
+func (a apiService) ListCommitsForPullRequest(ctx context.Context, params *commits.PullRequestParams) (*commits.ListCommitResponse, error) {
+	output, err := a.service.ListCommitsForPullRequest(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	}, int(params.Number))
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.Commit
+	_ = utils.UnPack(output, &list)
+	return &commits.ListCommitResponse{Data: list}, nil
+}
+
+func (a apiService) ListReleases(ctx context.Context, params *commits.RepositoryParams) (*commits.ListReleasesResponse, error) {
+	output, err := a.service.ListReleases(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.Release
+	_ = utils.UnPack(output, &list)
+	return &commits.ListReleasesResponse{Data: list}, nil
+}
+
+func (a apiService) ListCommitsBetween(ctx context.Context, params *commits.CommitsBetweenParams) (*commits.ListCommitResponse, error) {
+	output, err := a.service.ListCommitsBetween(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	}, params.FromRef, params.ToRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.Commit
+	_ = utils.UnPack(output, &list)
+	return &commits.ListCommitResponse{Data: list}, nil
+}
+
+func (a apiService) GetRepository(ctx context.Context, params *commits.RepositoryParams) (*commits.Repository, error) {
+	output, err := a.service.GetRepository(ctx, models.OwnerAndRepoName{
+		Host:      params.Host,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var repo commits.Repository
+	_ = utils.UnPack(output, &repo)
+	return &repo, nil
+}
+
+func (a apiService) ListRepositories(ctx context.Context, void *commits.Void) (*commits.ListRepositoriesResponse, error) {
+	output, err := a.service.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.Repository
+	_ = utils.UnPack(output, &list)
+	return &commits.ListRepositoriesResponse{Data: list}, nil
+}
+
+func (a apiService) ImportMailmap(ctx context.Context, params *commits.ImportMailmapParams) (*commits.ImportMailmapResponse, error) {
+	imported, err := a.service.ImportMailmap(ctx, params.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commits.ImportMailmapResponse{Imported: int64(imported)}, nil
+}
+
+func (a apiService) ListAuthorAliases(ctx context.Context, void *commits.Void) (*commits.ListAuthorAliasesResponse, error) {
+	output, err := a.service.ListAuthorAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*commits.AuthorAlias
+	_ = utils.UnPack(output, &list)
+	return &commits.ListAuthorAliasesResponse{Data: list}, nil
+}
+
+func (a apiService) SaveAuthorAlias(ctx context.Context, params *commits.AuthorAlias) (*commits.Void, error) {
+	err := a.service.SaveAuthorAlias(ctx, models.AuthorAlias{
+		CanonicalName:  params.CanonicalName,
+		CanonicalEmail: params.CanonicalEmail,
+		AliasName:      params.AliasName,
+		AliasEmail:     params.AliasEmail,
+	})
+	return &commits.Void{}, err
+}
+
+func (a apiService) DeleteAuthorAlias(ctx context.Context, params *commits.AuthorAlias) (*commits.Void, error) {
+	err := a.service.DeleteAuthorAlias(ctx, models.AuthorAlias{
+		AliasName:  params.AliasName,
+		AliasEmail: params.AliasEmail,
+	})
+	return &commits.Void{}, err
+}
+
+func (a apiService) GetChangelog(ctx context.Context, params *commits.ChangelogParams) (*commits.ChangelogResponse, error) {
+	changelogParams := models.ChangelogParams{
 		OwnerAndRepoName: models.OwnerAndRepoName{
+			Host:      params.Host,
 			OwnerName: params.OwnerName,
 			RepoName:  params.RepoName,
 		},
-		Limit:    params.Limit,
-		Page:     params.Page,
-		FromDate: nil,
-		ToDate:   nil,
+		Branch:  params.Branch,
+		FromSHA: params.FromSha,
+		ToSHA:   params.ToSha,
 	}
 
 	if params.FromDate != "" {
-		filter.FromDate, _ = models.ParseDate(params.FromDate) // This will be nil if the date format doesn't work out.
+		changelogParams.FromDate, _ = models.ParseDate(params.FromDate) // This will be nil if the date format doesn't work out.
 	}
 
 	if params.ToDate != "" {
-		filter.ToDate, _ = models.ParseDate(params.ToDate) // This will be nil if the date format doesn't work out.
+		changelogParams.ToDate, _ = models.ParseDate(params.ToDate) // This will be nil if the date format doesn't work out.
 	}
 
-	output, err := a.service.GetTopCommitAuthors(ctx, filter)
+	output, err := a.service.GetChangelog(ctx, changelogParams)
 	if err != nil {
 		return nil, err
 	}
 
-	var list []*commits.TopCommitAuthor
-	_ = utils.UnPack(output, &list)
-	return &commits.ListTopCommitAuthorResponse{Data: list}, nil
+	var sections []*commits.ChangelogSection
+	_ = utils.UnPack(output, &sections)
+	return &commits.ChangelogResponse{Data: sections}, nil
 }
 
+// HealthCheck reports the service as up along with the circuit breaker state of every source host,
+// as a failing host only stops the mirroring of the repositories on it.
 func (a apiService) HealthCheck(ctx context.Context, void *commits.Void) (*commits.HealthCheckResponse, error) {
-	return &commits.HealthCheckResponse{Code: 200}, nil
+	var sources []*commits.SourceHostHealth
+	_ = utils.UnPack(a.service.GetSourceHostsHealth(), &sources)
+	return &commits.HealthCheckResponse{Code: 200, Sources: sources}, nil
+}
+
+func toCommitFilters(params *commits.CommitFilterParams) models.CommitFilters {
+	filter := models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{
+			Host:      params.Host,
+			OwnerName: params.OwnerName,
+			RepoName:  params.RepoName,
+		},
+		Branch:           params.Branch,
+		Author:           params.Author,
+		Committer:        params.Committer,
+		GroupBy:          params.GroupBy,
+		Type:             params.Type,
+		Scope:            params.Scope,
+		Verification:     params.Verification,
+		CIConclusion:     params.CiConclusion,
+		IncludeCoAuthors: params.IncludeCoAuthors,
+		BreakingOnly:     params.BreakingOnly,
+		Limit:            params.Limit,
+		Page:             params.Page,
+		FromDate:         nil,
+		ToDate:           nil,
+	}
+
+	if params.FromDate != "" {
+		filter.FromDate, _ = models.ParseDate(params.FromDate) // This will be nil if the date format doesn't work out.
+	}
+
+	if params.ToDate != "" {
+		filter.ToDate, _ = models.ParseDate(params.ToDate) // This will be nil if the date format doesn't work out.
+	}
+
+	return filter
 }
 
 func NewApiService(
@@ -1,14 +1,24 @@
 package server
 
 import (
+	"context"
+	"errors"
 	commits "gitbeam.commit.monitor/pb"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
+// shutdownTimeout is how long requests in flight and the work they handed off get to finish on shutdown.
+const shutdownTimeout = 30 * time.Second
+
 func ExecGRPCServer(address string, api commits.GitBeamCommitsServiceServer) {
 	defer func() {
 		if err := recover(); err != nil {
@@ -29,39 +39,40 @@ func ExecGRPCServer(address string, api commits.GitBeamCommitsServiceServer) {
 	}
 }
 
-//// Channel to listen for signals
-//	signalChan := make(chan os.Signal, 1)
-//	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
-//
-//	// Channel to notify the server has been stopped
-//	shutdownChan := make(chan bool)
-//
-//	// Start server in a goroutine
-//	go func() {
-//		logger.Info("Started Server")
-//		if err := server.ListenAndServe(); err != nil {
-//			logger.WithError(err).Error("failed to start server")
-//			fmt.Printf("ListenAndServe(): %s\n", err)
-//		}
-//	}()
-//
-//	// Listen for shutdown signal
-//	go func() {
-//		<-signalChan
-//		logger.Info("Shutting down server...")
-//
-//		// Create a deadline to wait for.
-//		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-//		defer cancel()
-//
-//		// Attempt to gracefully shut down the server
-//		if err := server.Shutdown(ctx); err != nil {
-//			logger.WithError(err).Error("Server forced to shutdown")
-//		}
-//
-//		close(shutdownChan)
-//	}()
-//
-//	// Wait for shutdown signal
-//	<-shutdownChan
-//	logger.Info("Server gracefully stopped...")
+// ExecHTTPServer serves handler until the process is interrupted or terminated. It then stops taking requests, waits
+// for the ones in flight and, when handler hands work off to the background, for that work, before exiting.
+func ExecHTTPServer(address string, handler http.Handler) {
+	server := &http.Server{
+		Addr:              address,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	logrus.Info("Shutting down server...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Error("Server forced to shutdown")
+	}
+
+	if background, ok := handler.(interface{ Shutdown(context.Context) error }); ok {
+		if err := background.Shutdown(shutdownCtx); err != nil {
+			logrus.WithError(err).Error("Background work cut short by shutdown")
+		}
+	}
+
+	logrus.Info("Server gracefully stopped...")
+	os.Exit(0)
+}
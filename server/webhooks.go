@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"gitbeam.commit.monitor/core"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/scheduler"
+	"github.com/google/go-github/v63/github"
+	"github.com/sirupsen/logrus"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	// maxWebhookPayloadSize is the largest payload GitHub delivers, bigger ones are dropped by GitHub itself.
+	maxWebhookPayloadSize = 25 << 20
+
+	// webhookWorkers is how many pushes are mirrored at once and webhookQueueSize how many more wait their turn.
+	// Pushes arriving while the queue is full are turned away, the next scheduled sync picks them up.
+	webhookWorkers   = 4
+	webhookQueueSize = 64
+)
+
+// webhookPush is a verified push waiting to be mirrored.
+type webhookPush struct {
+	config  *models.MonitorRepositoryCommitConfig
+	filters models.CommitFilters // Filters of the pushed branch, under the name the repository was pushed as.
+	headSHA string
+}
+
+// pushQueue mirrors verified pushes on a fixed number of workers, so a burst of pushes doesn't start as many syncs.
+type pushQueue struct {
+	pushes  chan webhookPush
+	workers sync.WaitGroup
+	ctx     context.Context // Cancelled when shutdown gives up waiting for the queued pushes.
+	cancel  context.CancelFunc
+	mu      sync.RWMutex // Guards closed, pushes are only sent while the queue is open.
+	closed  bool
+}
+
+func newPushQueue(mirror func(ctx context.Context, push webhookPush)) *pushQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := &pushQueue{
+		pushes: make(chan webhookPush, webhookQueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	queue.workers.Add(webhookWorkers)
+	for i := 0; i < webhookWorkers; i++ {
+		go func() {
+			defer queue.workers.Done()
+			for push := range queue.pushes {
+				mirror(queue.ctx, push)
+			}
+		}()
+	}
+	return queue
+}
+
+// enqueue queues push to be mirrored, it returns false when the queue is full or shut down.
+func (q *pushQueue) enqueue(push webhookPush) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return false
+	}
+
+	select {
+	case q.pushes <- push:
+		return true
+	default:
+		return false
+	}
+}
+
+// shutdown stops taking pushes and waits for the queued ones to be mirrored. Once ctx is done, the pushes being
+// mirrored are cancelled and the rest are dropped.
+func (q *pushQueue) shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.pushes)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		return ctx.Err()
+	}
+}
+
+// WebhookHandler serves the webhooks of the git hosts that push to us and mirrors what they push in the background.
+type WebhookHandler struct {
+	http.Handler
+	queue *pushQueue
+}
+
+// Shutdown stops taking pushes and waits for the queued ones to be mirrored, cancelling them once ctx is done.
+func (h *WebhookHandler) Shutdown(ctx context.Context) error {
+	return h.queue.shutdown(ctx)
+}
+
+type webhookHandler struct {
+	service          *core.GitBeamService
+	schedulerService *scheduler.Scheduler
+	logger           *logrus.Logger
+	queue            *pushQueue
+}
+
+// ServeHTTP receives the push webhooks of monitored repositories, configured with the application/json content
+// type and the secret the repository is monitored with. Repositories on a GitHub Enterprise host send them
+// with the name of the host in the host query parameter, e.g. /webhooks/github?host=acme.
+//
+// Deliveries are rejected alike whether their signature is wrong or their repository isn't monitored with a
+// secret, so they tell nothing about which repositories are monitored. The push is acknowledged as soon as it is
+// verified and mirrored in the background, as GitHub gives up on deliveries after 10 seconds.
+func (h webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	useLogger := h.logger.WithContext(r.Context()).WithField("methodName", "ServeHTTP")
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		http.Error(w, "webhooks must be sent as application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+
+	// Only signed deliveries are looked at, unsigned ones can't come from a monitored repository.
+	signature := r.Header.Get(github.SHA256SignatureHeader)
+	if signature == "" {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	// The payload is only trusted once it has been verified with the secret of the repository it names.
+	var push github.PushEvent
+	if err := json.Unmarshal(payload, &push); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	name := models.OwnerAndRepoName{
+		Host:      r.URL.Query().Get("host"),
+		OwnerName: push.GetRepo().GetOwner().GetLogin(),
+		RepoName:  push.GetRepo().GetName(),
+	}
+
+	config := h.schedulerService.GetMonitorConfig(r.Context(), name)
+	if config == nil {
+		// A renamed or transferred repository pushes under its new name until its monitor followed it.
+		config = h.schedulerService.GetMonitorConfigBySourceID(r.Context(), name.Host, push.GetRepo().GetID())
+	}
+
+	if config == nil || config.WebhookSecret == "" || config.Provider != models.ProviderGithub {
+		useLogger.WithField("repository", name).Debug("rejected webhook of a repository not monitored with webhooks")
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	if err := github.ValidateSignature(signature, payload, []byte(config.WebhookSecret)); err != nil {
+		useLogger.WithError(err).WithField("repository", config.ID()).Warn("rejected webhook with an invalid signature")
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	// Ping and other events are acknowledged, only pushes are mirrored.
+	if github.WebHookType(r) != "push" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	branch, isBranch := strings.CutPrefix(push.GetRef(), "refs/heads/")
+	if !isBranch || push.GetDeleted() || !config.MonitorsBranch(branch, push.GetRepo().GetDefaultBranch()) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	filters := models.CommitFilters{
+		OwnerAndRepoName: name,
+		Branch:           branch,
+		Fetcher:          config.Fetcher,
+	}
+
+	if config.FromDate != "" {
+		filters.FromDate, _ = models.ParseDate(config.FromDate) // Defaults to null if nothing.
+	}
+
+	if !h.queue.enqueue(webhookPush{config: config, filters: filters, headSHA: push.GetAfter()}) {
+		useLogger.WithField("repository", config.ID()).Warn("turned away push, too many pushes are waiting to be mirrored")
+		http.Error(w, "too many pushes waiting to be mirrored", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// mirror mirrors push, following its repository to the name it was pushed as first when it was renamed.
+func (h webhookHandler) mirror(ctx context.Context, push webhookPush) {
+	useLogger := h.logger.WithContext(ctx).WithField("methodName", "mirror").WithField("repository", push.config.ID())
+
+	if push.config.OwnerAndRepoName() != push.filters.OwnerAndRepoName {
+		if _, err := h.schedulerService.FollowRepository(ctx, push.config); err != nil {
+			useLogger.WithError(err).Error("failed to follow renamed repository")
+			return
+		}
+	}
+
+	if _, err := h.service.IngestPush(ctx, push.filters, push.headSHA); err != nil {
+		useLogger.WithError(err).Error("failed to ingest push")
+	}
+}
+
+// NewWebhookHandler serves the webhooks of the git hosts that push to us. Shutdown must be called once the server
+// stopped serving it, to finish mirroring the pushes it received.
+func NewWebhookHandler(
+	service *core.GitBeamService,
+	schedulerService *scheduler.Scheduler,
+	logger *logrus.Logger) *WebhookHandler {
+	handler := webhookHandler{
+		service:          service,
+		schedulerService: schedulerService,
+		logger:           logger,
+	}
+	handler.queue = newPushQueue(handler.mirror)
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks/github", handler)
+	return &WebhookHandler{Handler: mux, queue: handler.queue}
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"gitbeam.baselib/store"
+	"gitbeam.commit.monitor/core"
+	"gitbeam.commit.monitor/models"
+	"gitbeam.commit.monitor/repository"
+	"gitbeam.commit.monitor/repository/sqlite"
+	"gitbeam.commit.monitor/scheduler"
+	"github.com/sirupsen/logrus"
+)
+
+const testWebhookSecret = "s3cret"
+
+// webhookTest is a webhook handler mirroring from a fake GitHub Enterprise host named ghe, which records the
+// requests it gets. The repository o/r is monitored with a secret, o/plain without one and o/old has been renamed
+// to o/new since it was monitored.
+type webhookTest struct {
+	handler   *WebhookHandler
+	cronStore repository.CronServiceStore
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func newWebhookTest(t *testing.T) *webhookTest {
+	t.Helper()
+	test := &webhookTest{}
+
+	github := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		test.mu.Lock()
+		test.requests = append(test.requests, r.Method+" "+r.URL.Path)
+		test.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v3/repositories/42":
+			fmt.Fprint(w, `{"id":42,"name":"new","full_name":"o/new","default_branch":"main","owner":{"login":"o"}}`)
+		case strings.HasSuffix(r.URL.Path, "/commits"):
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+		}
+	}))
+	t.Cleanup(github.Close)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	dir := t.TempDir()
+	dataStore, err := sqlite.NewSqliteRepo(filepath.Join(dir, "commits.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if test.cronStore, err = sqlite.NewSqliteCronStore(filepath.Join(dir, "cron.db")); err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := core.NewGitBeamService(logger, store.NewEventStore(logger), dataStore, nil, []models.SourceHost{
+		{Provider: models.ProviderGithub, Name: "ghe", BaseURL: github.URL + "/api/v3/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cfg := range []models.MonitorRepositoryCommitConfig{
+		{Host: "ghe", Provider: models.ProviderGithub, OwnerName: "o", RepoName: "r", WebhookSecret: testWebhookSecret, DurationInHours: 1},
+		{Host: "ghe", Provider: models.ProviderGithub, OwnerName: "o", RepoName: "plain", DurationInHours: 1},
+		{Host: "ghe", Provider: models.ProviderGithub, OwnerName: "o", RepoName: "old", SourceID: 42, WebhookSecret: testWebhookSecret, DurationInHours: 1},
+	} {
+		if err := test.cronStore.SaveMonitorConfigs(context.Background(), cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	test.handler = NewWebhookHandler(service, scheduler.NewScheduler(service, test.cronStore, logger), logger)
+	t.Cleanup(func() { _ = test.handler.Shutdown(context.Background()) })
+	return test
+}
+
+// deliver delivers a webhook of event about repo to the handler, signed with secret unless it is empty.
+func (test *webhookTest) deliver(event, secret, repo, ref string) *httptest.ResponseRecorder {
+	owner, name, _ := strings.Cut(repo, "/")
+	id := 0
+	if name == "new" {
+		id = 42
+	}
+	payload := fmt.Sprintf(`{"ref":%q,"after":"abc","repository":{"id":%d,"name":%q,"default_branch":"main","owner":{"login":%q}}}`,
+		ref, id, name, owner)
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/github?host=ghe", strings.NewReader(payload))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-GitHub-Event", event)
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+		request.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	recorder := httptest.NewRecorder()
+	test.handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// requested tells whether the fake host was asked for path, once the pushes received were mirrored.
+func (test *webhookTest) requested(t *testing.T, path string) bool {
+	t.Helper()
+	if err := test.handler.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	test.mu.Lock()
+	defer test.mu.Unlock()
+	for _, request := range test.requests {
+		if request == "GET "+path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    string
+		secret   string
+		repo     string
+		ref      string
+		want     int
+		mirrored string // Path the fake host must have been asked for by the time the push is mirrored.
+	}{
+		{"valid signature", "push", testWebhookSecret, "o/r", "refs/heads/main", http.StatusAccepted, "/api/v3/repos/o/r/commits"},
+		{"bad signature", "push", "wrong", "o/r", "refs/heads/main", http.StatusUnauthorized, ""},
+		{"no signature", "push", "", "o/r", "refs/heads/main", http.StatusUnauthorized, ""},
+		{"monitored without secret", "push", testWebhookSecret, "o/plain", "refs/heads/main", http.StatusUnauthorized, ""},
+		{"not monitored", "push", testWebhookSecret, "o/unknown", "refs/heads/main", http.StatusUnauthorized, ""},
+		{"non-push event", "ping", testWebhookSecret, "o/r", "refs/heads/main", http.StatusNoContent, ""},
+		{"unmonitored branch", "push", testWebhookSecret, "o/r", "refs/heads/feature", http.StatusNoContent, ""},
+		{"tag", "push", testWebhookSecret, "o/r", "refs/tags/v1", http.StatusNoContent, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := newWebhookTest(t)
+
+			response := test.deliver(tt.event, tt.secret, tt.repo, tt.ref)
+			if response.Code != tt.want {
+				t.Fatalf("ServeHTTP() status = %d, want %d: %s", response.Code, tt.want, response.Body)
+			}
+			if tt.mirrored != "" && !test.requested(t, tt.mirrored) {
+				t.Errorf("push wasn't mirrored, requests = %v", test.requests)
+			}
+			if tt.mirrored == "" && test.requested(t, "/api/v3/repos/o/r/commits") {
+				t.Errorf("push was mirrored, requests = %v", test.requests)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerHidesMonitoredRepositories(t *testing.T) {
+	test := newWebhookTest(t)
+
+	badSignature := test.deliver("push", "wrong", "o/r", "refs/heads/main")
+	notMonitored := test.deliver("push", testWebhookSecret, "o/unknown", "refs/heads/main")
+	if badSignature.Code != notMonitored.Code || badSignature.Body.String() != notMonitored.Body.String() {
+		t.Errorf("bad signature = %d %q, not monitored = %d %q, want the same response",
+			badSignature.Code, badSignature.Body, notMonitored.Code, notMonitored.Body)
+	}
+}
+
+func TestWebhookHandlerFollowsRenamedRepository(t *testing.T) {
+	test := newWebhookTest(t)
+
+	// o/new is only known by the source ID of the monitor of o/old.
+	if response := test.deliver("push", testWebhookSecret, "o/new", "refs/heads/main"); response.Code != http.StatusAccepted {
+		t.Fatalf("ServeHTTP() status = %d, want %d: %s", response.Code, http.StatusAccepted, response.Body)
+	}
+	if !test.requested(t, "/api/v3/repos/o/new/commits") {
+		t.Errorf("push wasn't mirrored under the new name, requests = %v", test.requests)
+	}
+
+	renamed, err := test.cronStore.GetMonitorConfig(context.Background(), models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "new"})
+	if err != nil || renamed.SourceID != 42 {
+		t.Errorf("GetMonitorConfig() = %+v, %v, want the monitor moved to the new name", renamed, err)
+	}
+}
+
+func TestWebhookHandlerAfterShutdown(t *testing.T) {
+	test := newWebhookTest(t)
+	if err := test.handler.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if response := test.deliver("push", testWebhookSecret, "o/r", "refs/heads/main"); response.Code != http.StatusServiceUnavailable {
+		t.Errorf("ServeHTTP() status = %d, want %d", response.Code, http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,283 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"gitbeam.commit.monitor/models"
+	"github.com/google/go-github/v63/github"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// giteaSource reads from the Gitea REST API, whose commits follow GitHub's format closely enough to be
+// decoded with the go-github types. Gitea reports which files a commit changed, but not their line counts.
+type giteaSource struct {
+	client *restClient
+}
+
+func newGiteaSource(httpClient *http.Client, host models.SourceHost, token string) (*giteaSource, error) {
+	if host.BaseURL == "" {
+		return nil, errors.New("gitea hosts need a base url, e.g. https://gitea.example.com/api/v1/")
+	}
+
+	authValue := ""
+	if token != "" {
+		authValue = "token " + token
+	}
+
+	client, err := newRestClient(httpClient, host.BaseURL, "Authorization", authValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &giteaSource{client: client}, nil
+}
+
+func (s giteaSource) repo(owner models.OwnerAndRepoName) string {
+	return "repos/" + url.PathEscape(owner.OwnerName) + "/" + url.PathEscape(owner.RepoName)
+}
+
+func (s giteaSource) ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error) {
+	query := url.Values{
+		"page":  {strconv.Itoa(options.Page)},
+		"limit": {strconv.Itoa(options.PerPage)},
+		"stat":  {"false"},
+		"files": {"false"},
+	}
+
+	if options.Branch != "" {
+		query.Set("sha", options.Branch)
+	}
+
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+
+	if !options.Until.IsZero() {
+		query.Set("until", options.Until.Format(time.RFC3339))
+	}
+
+	var gitCommits []*github.RepositoryCommit
+	response, err := s.client.get(ctx, s.repo(owner)+"/commits", query, &gitCommits)
+	if err != nil {
+		return nil, response, err
+	}
+
+	commits := make([]*models.Commit, 0, len(gitCommits))
+	for _, gitCommit := range gitCommits {
+		commits = append(commits, toCommit(owner, gitCommit))
+	}
+
+	return commits, response, nil
+}
+
+// GetCommit lists every file of the commit at once, as Gitea doesn't page them.
+func (s giteaSource) GetCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string, page int) (*CommitDetails, *Response, error) {
+	var gitCommit github.RepositoryCommit
+	response, err := s.client.get(ctx, s.repo(owner)+"/git/commits/"+url.PathEscape(sha), url.Values{
+		"stat":  {"true"},
+		"files": {"true"},
+	}, &gitCommit)
+	if err != nil {
+		return nil, response, err
+	}
+	response.NextPage = 0
+
+	details := &CommitDetails{
+		Commit: toCommit(owner, &gitCommit),
+		Files:  make([]*models.CommitFile, 0, len(gitCommit.Files)),
+	}
+
+	for _, file := range gitCommit.Files {
+		details.Files = append(details.Files, &models.CommitFile{
+			SHA:      sha,
+			Filename: file.GetFilename(),
+			Status:   file.GetStatus(),
+		})
+	}
+
+	return details, response, nil
+}
+
+func (s giteaSource) ListBranches(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]string, *Response, error) {
+	var branches []struct {
+		Name string `json:"name"`
+	}
+
+	response, err := s.client.get(ctx, s.repo(owner)+"/branches", url.Values{
+		"page":  {strconv.Itoa(page)},
+		"limit": {"50"}, // Gitea's default maximum page size.
+	}, &branches)
+	if err != nil {
+		return nil, response, err
+	}
+
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		names = append(names, branch.Name)
+	}
+
+	return names, response, nil
+}
+
+// GetRepository reports the last update of the repository as its last push, as Gitea doesn't track pushes alone.
+// Gitea redirects the old name of a renamed or transferred repository to the new one, which it is named after.
+func (s giteaSource) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, *Response, error) {
+	return s.getRepository(ctx, owner.Host, s.repo(owner))
+}
+
+func (s giteaSource) GetRepositoryByID(ctx context.Context, host string, id int64) (*models.Repository, *Response, error) {
+	return s.getRepository(ctx, host, "repositories/"+strconv.FormatInt(id, 10))
+}
+
+func (s giteaSource) getRepository(ctx context.Context, host, path string) (*models.Repository, *Response, error) {
+	var repo struct {
+		UpdatedAt *time.Time `json:"updated_at"`
+		Owner     struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		Name          string   `json:"name"`
+		DefaultBranch string   `json:"default_branch"`
+		Description   string   `json:"description"`
+		Topics        []string `json:"topics"`
+		ID            int64    `json:"id"`
+		StarsCount    int      `json:"stars_count"`
+		Private       bool     `json:"private"`
+		Internal      bool     `json:"internal"`
+		Archived      bool     `json:"archived"`
+	}
+
+	response, err := s.client.get(ctx, path, nil, &repo)
+	if err != nil {
+		return nil, response, err
+	}
+
+	visibility := "public"
+	switch {
+	case repo.Private:
+		visibility = "private"
+	case repo.Internal:
+		visibility = "internal"
+	}
+
+	return &models.Repository{
+		PushedAt:      repo.UpdatedAt,
+		SourceID:      repo.ID,
+		Host:          host,
+		OwnerName:     repo.Owner.Login,
+		RepoName:      repo.Name,
+		DefaultBranch: repo.DefaultBranch,
+		Visibility:    visibility,
+		Description:   repo.Description,
+		Topics:        repo.Topics,
+		Stars:         repo.StarsCount,
+		Archived:      repo.Archived,
+	}, response, nil
+}
+
+func (s giteaSource) ListTags(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Tag, *Response, error) {
+	var repoTags []*github.RepositoryTag
+	response, err := s.client.get(ctx, s.repo(owner)+"/tags", url.Values{
+		"page":  {strconv.Itoa(page)},
+		"limit": {"50"},
+	}, &repoTags)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return toTags(owner, repoTags), response, nil
+}
+
+func (s giteaSource) ListReleases(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Release, *Response, error) {
+	var repoReleases []*github.RepositoryRelease
+	response, err := s.client.get(ctx, s.repo(owner)+"/releases", url.Values{
+		"page":  {strconv.Itoa(page)},
+		"limit": {"50"},
+	}, &repoReleases)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return toReleases(owner, repoReleases), response, nil
+}
+
+// ListCommitPullRequests returns the pull request that merged the commit, as Gitea doesn't link commits to
+// the pull requests they were only pushed to.
+func (s giteaSource) ListCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.PullRequest, *Response, error) {
+	var pull github.PullRequest
+	response, err := s.client.get(ctx, s.repo(owner)+"/commits/"+url.PathEscape(sha)+"/pull", nil, &pull)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		// No pull request merged the commit.
+		return make([]*models.PullRequest, 0), response, nil
+	case err != nil:
+		return nil, response, err
+	}
+
+	return []*models.PullRequest{toPullRequest(&pull)}, response, nil
+}
+
+// GetCommitChecks reads the combined status of a commit, which is where Gitea Actions and other CI report.
+// Gitea has no check runs.
+func (s giteaSource) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, *Response, error) {
+	var combined struct {
+		State      string `json:"state"`
+		TotalCount int    `json:"total_count"`
+		Statuses   []struct {
+			UpdatedAt   *time.Time `json:"updated_at"`
+			Context     string     `json:"context"`
+			Status      string     `json:"status"`
+			Description string     `json:"description"`
+			TargetURL   string     `json:"target_url"`
+		} `json:"statuses"`
+	}
+
+	response, err := s.client.get(ctx, s.repo(owner)+"/commits/"+url.PathEscape(sha)+"/status", url.Values{
+		"limit": {"50"},
+	}, &combined)
+	if err != nil {
+		return nil, response, err
+	}
+
+	checks := &models.CommitChecks{
+		Host:      owner.Host,
+		OwnerName: owner.OwnerName,
+		RepoName:  owner.RepoName,
+		SHA:       sha,
+		Statuses:  make([]*models.CommitStatus, 0, len(combined.Statuses)),
+		CheckRuns: make([]*models.CheckRun, 0),
+	}
+
+	if combined.TotalCount > 0 {
+		checks.State = combined.State
+	}
+
+	for _, status := range combined.Statuses {
+		checks.Statuses = append(checks.Statuses, &models.CommitStatus{
+			UpdatedAt:   status.UpdatedAt,
+			Context:     status.Context,
+			State:       status.Status,
+			Description: status.Description,
+			TargetURL:   status.TargetURL,
+		})
+	}
+
+	checks.Conclude()
+	return checks, response, nil
+}
+
+// IsAncestor compares in reverse: when ancestor is one, descendant reaches every commit ancestor does.
+func (s giteaSource) IsAncestor(ctx context.Context, owner models.OwnerAndRepoName, ancestor, descendant string) (bool, *Response, error) {
+	var comparison struct {
+		TotalCommits int `json:"total_commits"`
+	}
+
+	response, err := s.client.get(ctx, s.repo(owner)+"/compare/"+url.PathEscape(descendant)+"..."+url.PathEscape(ancestor), nil, &comparison)
+	if err != nil {
+		return false, response, err
+	}
+
+	return comparison.TotalCommits == 0, response, nil
+}
@@ -0,0 +1,110 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestGiteaSourceListCommits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/v1/repos/o/r/commits" || r.URL.Query().Get("sha") != "main" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "40")
+		page := r.URL.Query().Get("page")
+		if page == "1" {
+			w.Header().Set("Link", `<http://`+r.Host+`/api/v1/repos/o/r/commits?limit=50&page=2>; rel="next", <http://`+r.Host+`/api/v1/repos/o/r/commits?limit=50&page=2>; rel="last"`)
+		}
+		fmt.Fprintf(w, `[{"sha":"s%s","html_url":"u","parents":[{"sha":"p%s"}],"author":{"login":"al","id":5},
+			"commit":{"message":"m","author":{"name":"A","email":"a@x","date":"2024-01-01T00:00:00Z"},
+			"committer":{"name":"C","email":"c@x","date":"2024-01-02T00:00:00Z"},"verification":{"verified":true,"reason":"valid"}}}]`, page, page)
+	}))
+	defer server.Close()
+
+	src, err := New(nil, models.SourceHost{Provider: models.ProviderGitea, BaseURL: server.URL + "/api/v1/"}, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := models.OwnerAndRepoName{Host: "gitea", OwnerName: "o", RepoName: "r"}
+	options := ListCommitsOptions{Branch: "main", Page: 1, PerPage: 50}
+	var shas []string
+	for options.Page != 0 {
+		commits, response, err := src.ListCommits(context.Background(), owner, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Rate.Limit != 100 || response.Rate.Remaining != 40 {
+			t.Errorf("Rate = %+v", response.Rate)
+		}
+		for _, commit := range commits {
+			if commit.Host != "gitea" || commit.AuthorLogin != "al" || !commit.Verification.Verified || commit.Date.Day() != 2 {
+				t.Errorf("commit = %+v", commit)
+			}
+			shas = append(shas, commit.SHA)
+		}
+		options.Page = response.NextPage
+	}
+
+	if fmt.Sprint(shas) != "[s1 s2]" {
+		t.Errorf("ListCommits() listed %v, want [s1 s2]", shas)
+	}
+}
+
+func TestGiteaSourceListCommitPullRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/o/r/commits/s1/pull" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"number":2,"state":"closed","merged":true,"merged_at":"2024-01-01T00:00:00Z","html_url":"h",
+			"user":{"login":"u"},"base":{"ref":"main"},"head":{"ref":"f"}}`)
+	}))
+	defer server.Close()
+
+	src, err := New(nil, models.SourceHost{Provider: models.ProviderGitea, BaseURL: server.URL + "/api/v1/"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	pulls, _, err := src.ListCommitPullRequests(context.Background(), owner, "s1")
+	if err != nil || len(pulls) != 1 || pulls[0].State != "merged" || pulls[0].HeadBranch != "f" {
+		t.Fatalf("ListCommitPullRequests() = %v, %v", pulls, err)
+	}
+
+	// Gitea reports commits no pull request merged as not found.
+	if pulls, _, err = src.ListCommitPullRequests(context.Background(), owner, "s2"); err != nil || pulls == nil || len(pulls) != 0 {
+		t.Errorf("ListCommitPullRequests() = %v, %v, want none", pulls, err)
+	}
+}
+
+func TestGiteaSourceErrors(t *testing.T) {
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	for _, tt := range statusErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.header)
+			src, err := New(nil, models.SourceHost{Provider: models.ProviderGitea, BaseURL: server.URL + "/api/v1/"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = src.GetRepository(context.Background(), owner)
+			checkStatusError(t, err, tt.want)
+			_, _, err = src.ListCommits(context.Background(), owner, ListCommitsOptions{Page: 1, PerPage: 50})
+			checkStatusError(t, err, tt.want)
+		})
+	}
+}
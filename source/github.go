@@ -0,0 +1,430 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"github.com/google/go-github/v63/github"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type githubSource struct {
+	client *github.Client
+}
+
+func newGithubSource(httpClient *http.Client, host models.SourceHost, token string) (*githubSource, error) {
+	client := github.NewClient(httpClient)
+	if host.BaseURL != "" {
+		uploadURL := host.UploadURL
+		if uploadURL == "" {
+			uploadURL = host.BaseURL
+		}
+
+		var err error
+		if client, err = client.WithEnterpriseURLs(host.BaseURL, uploadURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if token != "" {
+		client = client.WithAuthToken(token)
+	}
+
+	return &githubSource{client: client}, nil
+}
+
+func (s githubSource) ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error) {
+	gitCommits, response, err := s.client.Repositories.ListCommits(ctx, owner.OwnerName, owner.RepoName, &github.CommitsListOptions{
+		SHA:   options.Branch,
+		Since: options.Since,
+		Until: options.Until,
+		ListOptions: github.ListOptions{
+			Page:    options.Page,
+			PerPage: options.PerPage,
+		},
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	commits := make([]*models.Commit, 0, len(gitCommits))
+	for _, gitCommit := range gitCommits {
+		commits = append(commits, toCommit(owner, gitCommit))
+	}
+
+	return commits, fromGithubResponse(response), nil
+}
+
+// GetCommit pages the files of large commits, which GitHub lists 300 at a time.
+func (s githubSource) GetCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string, page int) (*CommitDetails, *Response, error) {
+	gitCommit, response, err := s.client.Repositories.GetCommit(ctx, owner.OwnerName, owner.RepoName, sha, &github.ListOptions{
+		Page:    page,
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	details := &CommitDetails{
+		Commit: toCommit(owner, gitCommit),
+		Files:  make([]*models.CommitFile, 0, len(gitCommit.Files)),
+	}
+
+	for _, file := range gitCommit.Files {
+		details.Files = append(details.Files, &models.CommitFile{
+			SHA:              sha,
+			Filename:         file.GetFilename(),
+			Status:           file.GetStatus(),
+			PreviousFilename: file.GetPreviousFilename(),
+			Additions:        file.GetAdditions(),
+			Deletions:        file.GetDeletions(),
+			Changes:          file.GetChanges(),
+		})
+	}
+
+	return details, fromGithubResponse(response), nil
+}
+
+func (s githubSource) ListBranches(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]string, *Response, error) {
+	branches, response, err := s.client.Repositories.ListBranches(ctx, owner.OwnerName, owner.RepoName, &github.BranchListOptions{
+		ListOptions: github.ListOptions{
+			Page:    page,
+			PerPage: 100,
+		},
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		names = append(names, branch.GetName())
+	}
+
+	return names, fromGithubResponse(response), nil
+}
+
+func (s githubSource) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, *Response, error) {
+	repo, response, err := s.client.Repositories.Get(ctx, owner.OwnerName, owner.RepoName)
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	return toRepository(owner.Host, repo), fromGithubResponse(response), nil
+}
+
+func (s githubSource) GetRepositoryByID(ctx context.Context, host string, id int64) (*models.Repository, *Response, error) {
+	repo, response, err := s.client.Repositories.GetByID(ctx, id)
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	return toRepository(host, repo), fromGithubResponse(response), nil
+}
+
+// toRepository names the repository as GitHub does now, which differs from the name it was asked by once renamed.
+func toRepository(host string, repo *github.Repository) *models.Repository {
+	// Older GitHub Enterprise hosts only report whether a repository is private.
+	visibility := repo.GetVisibility()
+	if visibility == "" {
+		visibility = "public"
+		if repo.GetPrivate() {
+			visibility = "private"
+		}
+	}
+
+	repository := &models.Repository{
+		SourceID:      repo.GetID(),
+		Host:          host,
+		OwnerName:     repo.GetOwner().GetLogin(),
+		RepoName:      repo.GetName(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		Visibility:    visibility,
+		Description:   repo.GetDescription(),
+		Topics:        repo.Topics,
+		Stars:         repo.GetStargazersCount(),
+		Archived:      repo.GetArchived(),
+	}
+
+	if repo.PushedAt != nil {
+		repository.PushedAt = &repo.PushedAt.Time
+	}
+
+	return repository
+}
+
+func (s githubSource) ListTags(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Tag, *Response, error) {
+	repoTags, response, err := s.client.Repositories.ListTags(ctx, owner.OwnerName, owner.RepoName, &github.ListOptions{
+		Page:    page,
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	return toTags(owner, repoTags), fromGithubResponse(response), nil
+}
+
+func (s githubSource) ListReleases(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Release, *Response, error) {
+	repoReleases, response, err := s.client.Repositories.ListReleases(ctx, owner.OwnerName, owner.RepoName, &github.ListOptions{
+		Page:    page,
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	return toReleases(owner, repoReleases), fromGithubResponse(response), nil
+}
+
+// ListCommitPullRequests lists the first 100 pull requests of a commit, which covers every commit but the odd outlier.
+func (s githubSource) ListCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.PullRequest, *Response, error) {
+	pulls, response, err := s.client.PullRequests.ListPullRequestsWithCommit(ctx, owner.OwnerName, owner.RepoName, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	pullRequests := make([]*models.PullRequest, 0, len(pulls))
+	for _, pull := range pulls {
+		pullRequests = append(pullRequests, toPullRequest(pull))
+	}
+
+	return pullRequests, fromGithubResponse(response), nil
+}
+
+// GetCommitChecks takes a call for the statuses and one for the check runs, reporting the rate limit of the last.
+// Either lists the first 100, which is more than any CI setup reports on a commit.
+func (s githubSource) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, *Response, error) {
+	combined, response, err := s.client.Repositories.GetCombinedStatus(ctx, owner.OwnerName, owner.RepoName, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	runs, response, err := s.client.Checks.ListCheckRunsForRef(ctx, owner.OwnerName, owner.RepoName, sha, &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	checks := &models.CommitChecks{
+		Host:      owner.Host,
+		OwnerName: owner.OwnerName,
+		RepoName:  owner.RepoName,
+		SHA:       sha,
+		Statuses:  make([]*models.CommitStatus, 0, len(combined.Statuses)),
+		CheckRuns: make([]*models.CheckRun, 0, len(runs.CheckRuns)),
+	}
+
+	// GitHub reports pending as the combined state of a commit without statuses.
+	if combined.GetTotalCount() > 0 {
+		checks.State = combined.GetState()
+	}
+
+	for _, status := range combined.Statuses {
+		commitStatus := &models.CommitStatus{
+			Context:     status.GetContext(),
+			State:       status.GetState(),
+			Description: status.GetDescription(),
+			TargetURL:   status.GetTargetURL(),
+		}
+
+		if status.UpdatedAt != nil {
+			commitStatus.UpdatedAt = &status.UpdatedAt.Time
+		}
+
+		checks.Statuses = append(checks.Statuses, commitStatus)
+	}
+
+	for _, run := range runs.CheckRuns {
+		checkRun := &models.CheckRun{
+			Name:       run.GetName(),
+			Status:     run.GetStatus(),
+			Conclusion: run.GetConclusion(),
+			DetailsURL: run.GetDetailsURL(),
+		}
+
+		if run.StartedAt != nil {
+			checkRun.StartedAt = &run.StartedAt.Time
+		}
+
+		if run.CompletedAt != nil {
+			checkRun.CompletedAt = &run.CompletedAt.Time
+		}
+
+		checks.CheckRuns = append(checks.CheckRuns, checkRun)
+	}
+
+	checks.Conclude()
+	return checks, fromGithubResponse(response), nil
+}
+
+func (s githubSource) IsAncestor(ctx context.Context, owner models.OwnerAndRepoName, ancestor, descendant string) (bool, *Response, error) {
+	comparison, response, err := s.client.Repositories.CompareCommits(ctx, owner.OwnerName, owner.RepoName, ancestor, descendant, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return false, fromGithubResponse(response), fromGithubError(err)
+	}
+
+	status := comparison.GetStatus()
+	return status == "ahead" || status == "identical", fromGithubResponse(response), nil
+}
+
+// toTags maps tags in the format of GitHub, which Gitea shares, into the model we store.
+func toTags(owner models.OwnerAndRepoName, repoTags []*github.RepositoryTag) []*models.Tag {
+	tags := make([]*models.Tag, 0, len(repoTags))
+	for _, repoTag := range repoTags {
+		tags = append(tags, &models.Tag{
+			Host:      owner.Host,
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+			Name:      repoTag.GetName(),
+			SHA:       repoTag.GetCommit().GetSHA(),
+		})
+	}
+
+	return tags
+}
+
+// toReleases maps releases in the format of GitHub, which Gitea shares, into the model we store.
+func toReleases(owner models.OwnerAndRepoName, repoReleases []*github.RepositoryRelease) []*models.Release {
+	releases := make([]*models.Release, 0, len(repoReleases))
+	for _, repoRelease := range repoReleases {
+		release := &models.Release{
+			Host:       owner.Host,
+			OwnerName:  owner.OwnerName,
+			RepoName:   owner.RepoName,
+			TagName:    repoRelease.GetTagName(),
+			Name:       repoRelease.GetName(),
+			Body:       repoRelease.GetBody(),
+			URL:        repoRelease.GetHTMLURL(),
+			Author:     repoRelease.GetAuthor().GetLogin(),
+			Draft:      repoRelease.GetDraft(),
+			Prerelease: repoRelease.GetPrerelease(),
+		}
+
+		if repoRelease.PublishedAt != nil {
+			release.PublishedAt = &repoRelease.PublishedAt.Time
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases
+}
+
+// toPullRequest maps a pull request in the format of GitHub, which Gitea shares, into the model we store.
+// Both report merged pull requests as closed, telling them apart by when they were merged.
+func toPullRequest(pull *github.PullRequest) *models.PullRequest {
+	pullRequest := &models.PullRequest{
+		Number:     pull.GetNumber(),
+		Title:      pull.GetTitle(),
+		State:      pull.GetState(),
+		URL:        pull.GetHTMLURL(),
+		Author:     pull.GetUser().GetLogin(),
+		BaseBranch: pull.GetBase().GetRef(),
+		HeadBranch: pull.GetHead().GetRef(),
+	}
+
+	if pull.MergedAt != nil {
+		pullRequest.MergedAt = &pull.MergedAt.Time
+		pullRequest.State = models.PullRequestMerged
+	}
+
+	return pullRequest
+}
+
+// toCommit maps a commit in the format of GitHub, which Gitea shares, into the model we store.
+// The git identities come from the commit itself, the logins from the GitHub accounts their emails are linked to.
+func toCommit(owner models.OwnerAndRepoName, gitCommit *github.RepositoryCommit) *models.Commit {
+	c := gitCommit.GetCommit()
+
+	commit := &models.Commit{
+		SHA:             gitCommit.GetSHA(),
+		Message:         c.GetMessage(),
+		Author:          c.GetAuthor().GetName(),
+		AuthorEmail:     c.GetAuthor().GetEmail(),
+		AuthorDate:      c.GetAuthor().GetDate().Time,
+		AuthorLogin:     gitCommit.GetAuthor().GetLogin(),
+		AuthorID:        gitCommit.GetAuthor().GetID(),
+		CommitterName:   c.GetCommitter().GetName(),
+		CommitterEmail:  c.GetCommitter().GetEmail(),
+		CommitterLogin:  gitCommit.GetCommitter().GetLogin(),
+		CommitterID:     gitCommit.GetCommitter().GetID(),
+		Date:            c.GetCommitter().GetDate().Time,
+		URL:             gitCommit.GetHTMLURL(),
+		Host:            owner.Host,
+		OwnerName:       owner.OwnerName,
+		RepoName:        owner.RepoName,
+		Additions:       gitCommit.GetStats().GetAdditions(),
+		Deletions:       gitCommit.GetStats().GetDeletions(),
+		ParentCommitIDs: make([]string, 0),
+	}
+
+	if verification := c.GetVerification(); verification != nil {
+		commit.Verification = &models.CommitVerification{
+			Verified:  verification.GetVerified(),
+			Reason:    verification.GetReason(),
+			Signature: verification.GetSignature(),
+		}
+	}
+
+	parents := gitCommit.Parents
+	for _, parent := range parents {
+		commit.ParentCommitIDs = append(commit.ParentCommitIDs, parent.GetSHA())
+	}
+
+	return commit
+}
+
+func fromGithubResponse(response *github.Response) *Response {
+	if response == nil || response.Response == nil {
+		return nil
+	}
+
+	converted := &Response{
+		NextPage: response.NextPage,
+		Rate: RateLimit{
+			Limit:     response.Rate.Limit,
+			Remaining: response.Rate.Remaining,
+			Reset:     response.Rate.Reset.Time,
+		},
+	}
+
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+		converted.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return converted
+}
+
+func fromGithubError(err error) error {
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	var errorResponse *github.ErrorResponse
+
+	switch {
+	case errors.As(err, &rateLimitErr):
+		return &RateLimitError{Reset: rateLimitErr.Rate.Reset.Time, Message: rateLimitErr.Message}
+	case errors.As(err, &abuseErr):
+		limitErr := &RateLimitError{Message: abuseErr.Message}
+		if abuseErr.RetryAfter != nil {
+			limitErr.RetryAfter = *abuseErr.RetryAfter
+		}
+		return limitErr
+	case errors.As(err, &errorResponse):
+		switch status := errorResponse.Response.StatusCode; {
+		case status == http.StatusNotFound, status == http.StatusGone:
+			return fmt.Errorf("%w: %v", ErrNotFound, err)
+		case status == http.StatusUnauthorized, status == http.StatusForbidden:
+			return fmt.Errorf("%w: %v", ErrAccessDenied, err)
+		case status >= http.StatusInternalServerError:
+			return fmt.Errorf("%w: %v", ErrUnavailable, err)
+		}
+		return err
+	}
+
+	return fromTransportError(err)
+}
@@ -0,0 +1,306 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// historyQuery lists a page of the history of a branch, along with what the query cost. Listing parents and
+// pull requests per commit makes a page cost a few points of the GraphQL rate limit instead of one.
+const historyQuery = `
+query($owner: String!, $name: String!, $ref: String!, $first: Int!, $after: String, $since: GitTimestamp, $until: GitTimestamp) {
+  rateLimit { cost limit remaining resetAt }
+  repository(owner: $owner, name: $name) {
+    ref(qualifiedName: $ref) {
+      target {
+        ... on Commit {
+          history(first: $first, after: $after, since: $since, until: $until) {
+            pageInfo { hasNextPage endCursor }
+            nodes {
+              oid
+              url
+              message
+              additions
+              deletions
+              authoredDate
+              committedDate
+              author { name email user { login databaseId } }
+              committer { name email user { login databaseId } }
+              parents(first: 20) { nodes { oid } }
+              signature { state signature }
+              associatedPullRequests(first: 10) {
+                nodes { number title state url mergedAt baseRefName headRefName author { login } }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// githubGraphQLSource lists commits through the GraphQL API of GitHub, which returns a page of commits with
+// their line stats and pull requests in a single query. Pages are addressed by cursor, Page is only counted.
+// The files a commit changed are only listed by the REST API, like every other call.
+type githubGraphQLSource struct {
+	*githubSource
+}
+
+type graphQLActor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	User  *struct {
+		Login      string `json:"login"`
+		DatabaseID int64  `json:"databaseId"`
+	} `json:"user"`
+}
+
+type graphQLCommit struct {
+	AuthoredDate  time.Time    `json:"authoredDate"`
+	CommittedDate time.Time    `json:"committedDate"`
+	Author        graphQLActor `json:"author"`
+	Committer     graphQLActor `json:"committer"`
+	OID           string       `json:"oid"`
+	URL           string       `json:"url"`
+	Message       string       `json:"message"`
+	Additions     int          `json:"additions"`
+	Deletions     int          `json:"deletions"`
+	Parents       struct {
+		Nodes []struct {
+			OID string `json:"oid"`
+		} `json:"nodes"`
+	} `json:"parents"`
+	Signature *struct {
+		State     string `json:"state"`
+		Signature string `json:"signature"`
+	} `json:"signature"`
+	AssociatedPullRequests struct {
+		Nodes []struct {
+			MergedAt    *time.Time `json:"mergedAt"`
+			Title       string     `json:"title"`
+			State       string     `json:"state"`
+			URL         string     `json:"url"`
+			BaseRefName string     `json:"baseRefName"`
+			HeadRefName string     `json:"headRefName"`
+			Number      int        `json:"number"`
+			Author      *struct {
+				Login string `json:"login"`
+			} `json:"author"`
+		} `json:"nodes"`
+	} `json:"associatedPullRequests"`
+}
+
+type graphQLHistoryResponse struct {
+	Data struct {
+		RateLimit *struct {
+			ResetAt   time.Time `json:"resetAt"`
+			Cost      int       `json:"cost"`
+			Limit     int       `json:"limit"`
+			Remaining int       `json:"remaining"`
+		} `json:"rateLimit"`
+		Repository *struct {
+			Ref *struct {
+				Target struct {
+					History *struct {
+						PageInfo struct {
+							EndCursor   string `json:"endCursor"`
+							HasNextPage bool   `json:"hasNextPage"`
+						} `json:"pageInfo"`
+						Nodes []*graphQLCommit `json:"nodes"`
+					} `json:"history"`
+				} `json:"target"`
+			} `json:"ref"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func newGithubGraphQLSource(httpClient *http.Client, host models.SourceHost, token string) (*githubGraphQLSource, error) {
+	rest, err := newGithubSource(httpClient, host, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &githubGraphQLSource{githubSource: rest}, nil
+}
+
+func (s githubGraphQLSource) ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error) {
+	variables := map[string]any{
+		"owner": owner.OwnerName,
+		"name":  owner.RepoName,
+		"ref":   "HEAD",
+		"first": options.PerPage,
+	}
+
+	if options.Branch != "" {
+		variables["ref"] = "refs/heads/" + options.Branch
+	}
+
+	if options.Cursor != "" {
+		variables["after"] = options.Cursor
+	}
+
+	if !options.Since.IsZero() {
+		variables["since"] = options.Since.UTC().Format(time.RFC3339)
+	}
+
+	if !options.Until.IsZero() {
+		variables["until"] = options.Until.UTC().Format(time.RFC3339)
+	}
+
+	// The GraphQL endpoint sits next to the REST root, i.e. api.github.com/graphql or <host>/api/graphql.
+	request, err := s.client.NewRequest(http.MethodPost, "../graphql", map[string]any{
+		"query":     historyQuery,
+		"variables": variables,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var body graphQLHistoryResponse
+	githubResponse, err := s.client.Do(ctx, request, &body)
+	response := fromGithubResponse(githubResponse)
+	if err != nil {
+		return nil, response, fromGithubError(err)
+	}
+
+	if rate := body.Data.RateLimit; rate != nil {
+		response.Cost = rate.Cost
+		response.Rate = RateLimit{Reset: rate.ResetAt, Limit: rate.Limit, Remaining: rate.Remaining}
+	}
+
+	if err := fromGraphQLErrors(body, response); err != nil {
+		return nil, response, err
+	}
+
+	repo := body.Data.Repository
+	if repo == nil || repo.Ref == nil || repo.Ref.Target.History == nil {
+		return nil, response, fmt.Errorf("%w: branch %s of %s/%s", ErrNotFound, options.Branch, owner.OwnerName, owner.RepoName)
+	}
+
+	history := repo.Ref.Target.History
+	response.NextPage, response.NextCursor = 0, ""
+	if history.PageInfo.HasNextPage {
+		response.NextPage = max(options.Page, 1) + 1
+		response.NextCursor = history.PageInfo.EndCursor
+	}
+
+	commits := make([]*models.Commit, 0, len(history.Nodes))
+	for _, node := range history.Nodes {
+		commits = append(commits, node.toCommit(owner))
+	}
+
+	return commits, response, nil
+}
+
+// fromGraphQLErrors maps the errors GitHub reports in the body of a successful response.
+func fromGraphQLErrors(body graphQLHistoryResponse, response *Response) error {
+	if len(body.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(body.Errors))
+	for _, graphQLErr := range body.Errors {
+		switch graphQLErr.Type {
+		case "NOT_FOUND":
+			return fmt.Errorf("%w: %s", ErrNotFound, graphQLErr.Message)
+		case "RATE_LIMITED":
+			return &RateLimitError{Reset: response.Rate.Reset, Message: graphQLErr.Message, RetryAfter: response.RetryAfter}
+		case "FORBIDDEN":
+			return fmt.Errorf("%w: %s", ErrAccessDenied, graphQLErr.Message)
+		}
+		messages = append(messages, graphQLErr.Message)
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func (c graphQLCommit) toCommit(owner models.OwnerAndRepoName) *models.Commit {
+	commit := &models.Commit{
+		SHA:             c.OID,
+		Message:         c.Message,
+		Author:          c.Author.Name,
+		AuthorEmail:     c.Author.Email,
+		AuthorDate:      c.AuthoredDate,
+		CommitterName:   c.Committer.Name,
+		CommitterEmail:  c.Committer.Email,
+		Date:            c.CommittedDate,
+		URL:             c.URL,
+		Host:            owner.Host,
+		OwnerName:       owner.OwnerName,
+		RepoName:        owner.RepoName,
+		Additions:       c.Additions,
+		Deletions:       c.Deletions,
+		ParentCommitIDs: make([]string, 0, len(c.Parents.Nodes)),
+		PullRequests:    make([]*models.PullRequest, 0, len(c.AssociatedPullRequests.Nodes)),
+		Verification: &models.CommitVerification{
+			Reason: models.VerificationUnsigned,
+		},
+	}
+
+	if user := c.Author.User; user != nil {
+		commit.AuthorLogin, commit.AuthorID = user.Login, user.DatabaseID
+	}
+
+	if user := c.Committer.User; user != nil {
+		commit.CommitterLogin, commit.CommitterID = user.Login, user.DatabaseID
+	}
+
+	// Like REST, a signature only verifies the commit when it is valid and made by a key GitHub knows the signer of,
+	// which isValid doesn't say.
+	if signature := c.Signature; signature != nil {
+		commit.Verification = &models.CommitVerification{
+			Verified:  signature.State == "VALID",
+			Reason:    verificationReason(signature.State),
+			Signature: signature.Signature,
+		}
+	}
+
+	for _, parent := range c.Parents.Nodes {
+		commit.ParentCommitIDs = append(commit.ParentCommitIDs, parent.OID)
+	}
+
+	for _, node := range c.AssociatedPullRequests.Nodes {
+		pullRequest := &models.PullRequest{
+			Number:     node.Number,
+			Title:      node.Title,
+			State:      strings.ToLower(node.State),
+			URL:        node.URL,
+			BaseBranch: node.BaseRefName,
+			HeadBranch: node.HeadRefName,
+			MergedAt:   node.MergedAt,
+		}
+
+		if node.Author != nil {
+			pullRequest.Author = node.Author.Login
+		}
+
+		commit.PullRequests = append(commit.PullRequests, pullRequest)
+	}
+
+	return commit
+}
+
+// restVerificationReasons maps the GitSignatureState of GraphQL onto the verification reason the REST API reports for
+// the same signature. The other states are the REST reasons in upper case.
+var restVerificationReasons = map[string]string{
+	"MALFORMED_SIG":    "malformed_signature",
+	"UNKNOWN_SIG_TYPE": "unknown_signature_type",
+}
+
+// verificationReason returns the REST verification reason of a GraphQL signature state, so commits listed through
+// either API are filtered and counted alike.
+func verificationReason(state string) string {
+	if reason, ok := restVerificationReasons[state]; ok {
+		return reason
+	}
+	return strings.ToLower(state)
+}
@@ -0,0 +1,57 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestGithubGraphQLSourceVerification(t *testing.T) {
+	tests := []struct {
+		name         string
+		signature    string
+		wantVerified bool
+		wantReason   string
+	}{
+		{"valid", `{"isValid":true,"state":"VALID","signature":"sig"}`, true, "valid"},
+		{"unknown key", `{"isValid":true,"state":"UNKNOWN_KEY","signature":"sig"}`, false, "unknown_key"},
+		{"bad signature", `{"isValid":false,"state":"INVALID","signature":"sig"}`, false, "invalid"},
+		{"malformed signature", `{"isValid":false,"state":"MALFORMED_SIG","signature":"sig"}`, false, "malformed_signature"},
+		{"unknown signature type", `{"isValid":false,"state":"UNKNOWN_SIG_TYPE","signature":"sig"}`, false, "unknown_signature_type"},
+		{"expired key", `{"isValid":true,"state":"EXPIRED_KEY","signature":"sig"}`, false, "expired_key"},
+		{"unsigned", `null`, false, models.VerificationUnsigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data":{"repository":{"ref":{"target":{"history":{"pageInfo":{"hasNextPage":false},"nodes":[
+					{"oid":"s1","message":"m","author":{"name":"A"},"committer":{"name":"C"},"parents":{"nodes":[]},
+					 "signature":%s,"associatedPullRequests":{"nodes":[]}}]}}}}}}`, tt.signature)
+			}))
+			defer server.Close()
+
+			src, err := NewGraphQL(nil, models.SourceHost{Provider: models.ProviderGithub, BaseURL: server.URL + "/api/v3/"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			commits, _, err := src.ListCommits(context.Background(), models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}, ListCommitsOptions{
+				Branch:  "main",
+				PerPage: 100,
+			})
+			if err != nil || len(commits) != 1 {
+				t.Fatalf("ListCommits() = %v, %v", commits, err)
+			}
+
+			if got := commits[0].Verification; got.Verified != tt.wantVerified || got.Reason != tt.wantReason {
+				t.Errorf("Verification = %+v, want verified %v with reason %q", got, tt.wantVerified, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestGithubSourceListCommits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/v3/repos/o/r/commits" || r.URL.Query().Get("sha") != "main" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4990")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		page := r.URL.Query().Get("page")
+		if page == "1" {
+			w.Header().Set("Link", `<http://`+r.Host+`/api/v3/repos/o/r/commits?page=2&per_page=100&sha=main>; rel="next"`)
+		}
+		fmt.Fprintf(w, `[{"sha":"s%s","html_url":"u","parents":[{"sha":"p%s"}],"author":{"login":"al","id":5},
+			"commit":{"message":"m","author":{"name":"A","email":"a@x","date":"2024-01-01T00:00:00Z"},
+			"committer":{"name":"C","email":"c@x","date":"2024-01-02T00:00:00Z"}}}]`, page, page)
+	}))
+	defer server.Close()
+
+	src, err := New(nil, models.SourceHost{Provider: models.ProviderGithub, BaseURL: server.URL + "/api/v3/"}, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := models.OwnerAndRepoName{Host: "ghe", OwnerName: "o", RepoName: "r"}
+	options := ListCommitsOptions{Branch: "main", Page: 1, PerPage: 100}
+	var shas []string
+	for options.Page != 0 {
+		commits, response, err := src.ListCommits(context.Background(), owner, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Rate.Limit != 5000 || response.Rate.Remaining != 4990 || !response.Rate.Reset.Equal(time.Unix(1700000000, 0)) {
+			t.Errorf("Rate = %+v", response.Rate)
+		}
+		for _, commit := range commits {
+			if commit.Host != "ghe" || commit.AuthorLogin != "al" || len(commit.ParentCommitIDs) != 1 {
+				t.Errorf("commit = %+v", commit)
+			}
+			shas = append(shas, commit.SHA)
+		}
+		options.Page = response.NextPage
+	}
+
+	if fmt.Sprint(shas) != "[s1 s2]" {
+		t.Errorf("ListCommits() listed %v, want [s1 s2]", shas)
+	}
+}
+
+func TestGithubSourceErrors(t *testing.T) {
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	for _, tt := range statusErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.header)
+			src, err := New(nil, models.SourceHost{Provider: models.ProviderGithub, BaseURL: server.URL + "/api/v3/"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = src.GetRepository(context.Background(), owner)
+			checkStatusError(t, err, tt.want)
+			_, _, err = src.ListCommits(context.Background(), owner, ListCommitsOptions{Page: 1, PerPage: 100})
+			checkStatusError(t, err, tt.want)
+		})
+	}
+}
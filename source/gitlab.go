@@ -0,0 +1,453 @@
+package source
+
+import (
+	"context"
+	"gitbeam.commit.monitor/models"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const gitlabDotComURL = "https://gitlab.com/api/v4/"
+
+// gitlabSource reads from the GitLab REST API, where the owner of a repository is its group or namespace path.
+// GitLab has no notion of linked accounts or signature verification on commits, so those are left empty.
+type gitlabSource struct {
+	client *restClient
+}
+
+type gitlabCommit struct {
+	AuthoredDate   time.Time `json:"authored_date"`
+	CommittedDate  time.Time `json:"committed_date"`
+	ID             string    `json:"id"`
+	Message        string    `json:"message"`
+	AuthorName     string    `json:"author_name"`
+	AuthorEmail    string    `json:"author_email"`
+	CommitterName  string    `json:"committer_name"`
+	CommitterEmail string    `json:"committer_email"`
+	WebURL         string    `json:"web_url"`
+	ParentIDs      []string  `json:"parent_ids"`
+	Stats          *struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+	} `json:"stats"`
+}
+
+type gitlabDiff struct {
+	OldPath     string `json:"old_path"`
+	NewPath     string `json:"new_path"`
+	Diff        string `json:"diff"`
+	NewFile     bool   `json:"new_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	DeletedFile bool   `json:"deleted_file"`
+}
+
+func newGitlabSource(httpClient *http.Client, host models.SourceHost, token string) (*gitlabSource, error) {
+	baseURL := host.BaseURL
+	if baseURL == "" {
+		baseURL = gitlabDotComURL
+	}
+
+	client, err := newRestClient(httpClient, baseURL, "PRIVATE-TOKEN", token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitlabSource{client: client}, nil
+}
+
+// project is the API path of a repository, which GitLab addresses by its URL encoded full path.
+func (s gitlabSource) project(owner models.OwnerAndRepoName) string {
+	return "projects/" + strings.ReplaceAll(url.PathEscape(owner.OwnerName+"/"+owner.RepoName), "/", "%2F")
+}
+
+func (s gitlabSource) ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error) {
+	query := url.Values{
+		"page":     {strconv.Itoa(options.Page)},
+		"per_page": {strconv.Itoa(options.PerPage)},
+	}
+
+	if options.Branch != "" {
+		query.Set("ref_name", options.Branch)
+	}
+
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+
+	if !options.Until.IsZero() {
+		query.Set("until", options.Until.Format(time.RFC3339))
+	}
+
+	var gitCommits []*gitlabCommit
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/commits", query, &gitCommits)
+	if err != nil {
+		return nil, response, err
+	}
+
+	commits := make([]*models.Commit, 0, len(gitCommits))
+	for _, gitCommit := range gitCommits {
+		commits = append(commits, gitCommit.toCommit(owner))
+	}
+
+	return commits, response, nil
+}
+
+// GetCommit reads the commit on the first page only, later pages just list more of its diffs.
+func (s gitlabSource) GetCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string, page int) (*CommitDetails, *Response, error) {
+	details := &CommitDetails{}
+	if page <= 1 {
+		var gitCommit gitlabCommit
+		response, err := s.client.get(ctx, s.project(owner)+"/repository/commits/"+url.PathEscape(sha), nil, &gitCommit)
+		if err != nil {
+			return nil, response, err
+		}
+		details.Commit = gitCommit.toCommit(owner)
+	}
+
+	var diffs []*gitlabDiff
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/commits/"+url.PathEscape(sha)+"/diff", url.Values{
+		"page":     {strconv.Itoa(page)},
+		"per_page": {"100"},
+	}, &diffs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	details.Files = make([]*models.CommitFile, 0, len(diffs))
+	for _, diff := range diffs {
+		details.Files = append(details.Files, diff.toCommitFile(sha))
+	}
+
+	return details, response, nil
+}
+
+func (s gitlabSource) ListBranches(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]string, *Response, error) {
+	var branches []struct {
+		Name string `json:"name"`
+	}
+
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/branches", url.Values{
+		"page":     {strconv.Itoa(page)},
+		"per_page": {"100"},
+	}, &branches)
+	if err != nil {
+		return nil, response, err
+	}
+
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		names = append(names, branch.Name)
+	}
+
+	return names, response, nil
+}
+
+// GetRepository reports the last activity on the project as its last push, as GitLab doesn't track pushes alone.
+// GitLab keeps answering to the old path of a moved project, so it is named after its current namespace and path.
+func (s gitlabSource) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, *Response, error) {
+	return s.getProject(ctx, owner.Host, s.project(owner))
+}
+
+func (s gitlabSource) GetRepositoryByID(ctx context.Context, host string, id int64) (*models.Repository, *Response, error) {
+	return s.getProject(ctx, host, "projects/"+strconv.FormatInt(id, 10))
+}
+
+func (s gitlabSource) getProject(ctx context.Context, host, path string) (*models.Repository, *Response, error) {
+	var project struct {
+		LastActivityAt *time.Time `json:"last_activity_at"`
+		Namespace      struct {
+			FullPath string `json:"full_path"`
+		} `json:"namespace"`
+		Path          string   `json:"path"`
+		DefaultBranch string   `json:"default_branch"`
+		Visibility    string   `json:"visibility"`
+		Description   string   `json:"description"`
+		Topics        []string `json:"topics"`
+		ID            int64    `json:"id"`
+		StarCount     int      `json:"star_count"`
+		Archived      bool     `json:"archived"`
+	}
+
+	response, err := s.client.get(ctx, path, nil, &project)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &models.Repository{
+		PushedAt:      project.LastActivityAt,
+		SourceID:      project.ID,
+		Host:          host,
+		OwnerName:     project.Namespace.FullPath,
+		RepoName:      project.Path,
+		DefaultBranch: project.DefaultBranch,
+		Visibility:    project.Visibility,
+		Description:   project.Description,
+		Topics:        project.Topics,
+		Stars:         project.StarCount,
+		Archived:      project.Archived,
+	}, response, nil
+}
+
+func (s gitlabSource) ListTags(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Tag, *Response, error) {
+	var projectTags []struct {
+		Name   string `json:"name"`
+		Commit struct {
+			ID string `json:"id"`
+		} `json:"commit"`
+	}
+
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/tags", url.Values{
+		"page":     {strconv.Itoa(page)},
+		"per_page": {"100"},
+	}, &projectTags)
+	if err != nil {
+		return nil, response, err
+	}
+
+	tags := make([]*models.Tag, 0, len(projectTags))
+	for _, projectTag := range projectTags {
+		tags = append(tags, &models.Tag{
+			Host:      owner.Host,
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+			Name:      projectTag.Name,
+			SHA:       projectTag.Commit.ID,
+		})
+	}
+
+	return tags, response, nil
+}
+
+// ListReleases lists the releases of a project. GitLab has no drafts or pre-releases, releases are published
+// when they are released.
+func (s gitlabSource) ListReleases(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Release, *Response, error) {
+	var projectReleases []struct {
+		ReleasedAt  *time.Time `json:"released_at"`
+		TagName     string     `json:"tag_name"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		Author      struct {
+			Username string `json:"username"`
+		} `json:"author"`
+		Links struct {
+			Self string `json:"self"`
+		} `json:"_links"`
+	}
+
+	response, err := s.client.get(ctx, s.project(owner)+"/releases", url.Values{
+		"page":     {strconv.Itoa(page)},
+		"per_page": {"100"},
+	}, &projectReleases)
+	if err != nil {
+		return nil, response, err
+	}
+
+	releases := make([]*models.Release, 0, len(projectReleases))
+	for _, projectRelease := range projectReleases {
+		releases = append(releases, &models.Release{
+			PublishedAt: projectRelease.ReleasedAt,
+			Host:        owner.Host,
+			OwnerName:   owner.OwnerName,
+			RepoName:    owner.RepoName,
+			TagName:     projectRelease.TagName,
+			Name:        projectRelease.Name,
+			Body:        projectRelease.Description,
+			URL:         projectRelease.Links.Self,
+			Author:      projectRelease.Author.Username,
+		})
+	}
+
+	return releases, response, nil
+}
+
+// ListCommitPullRequests lists the merge requests of a commit. Locked merge requests are on their way to being
+// merged, so they are reported as open.
+func (s gitlabSource) ListCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.PullRequest, *Response, error) {
+	var mergeRequests []struct {
+		MergedAt     *time.Time `json:"merged_at"`
+		IID          int        `json:"iid"`
+		Title        string     `json:"title"`
+		State        string     `json:"state"`
+		WebURL       string     `json:"web_url"`
+		TargetBranch string     `json:"target_branch"`
+		SourceBranch string     `json:"source_branch"`
+		Author       struct {
+			Username string `json:"username"`
+		} `json:"author"`
+	}
+
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/commits/"+url.PathEscape(sha)+"/merge_requests", url.Values{
+		"per_page": {"100"},
+	}, &mergeRequests)
+	if err != nil {
+		return nil, response, err
+	}
+
+	pullRequests := make([]*models.PullRequest, 0, len(mergeRequests))
+	for _, mergeRequest := range mergeRequests {
+		state := mergeRequest.State
+		if state == "opened" || state == "locked" {
+			state = models.PullRequestOpen
+		}
+
+		pullRequests = append(pullRequests, &models.PullRequest{
+			MergedAt:   mergeRequest.MergedAt,
+			Number:     mergeRequest.IID,
+			Title:      mergeRequest.Title,
+			State:      state,
+			URL:        mergeRequest.WebURL,
+			Author:     mergeRequest.Author.Username,
+			BaseBranch: mergeRequest.TargetBranch,
+			HeadBranch: mergeRequest.SourceBranch,
+		})
+	}
+
+	return pullRequests, response, nil
+}
+
+type gitlabCheckState struct{ status, conclusion string }
+
+// gitlabCheckStates maps the status of a GitLab job to the status and conclusion of a check run. Manual jobs don't
+// run until someone starts them, so they count as skipped.
+var gitlabCheckStates = map[string]gitlabCheckState{
+	"created":              {models.CheckRunQueued, ""},
+	"waiting_for_resource": {models.CheckRunQueued, ""},
+	"preparing":            {models.CheckRunQueued, ""},
+	"pending":              {models.CheckRunQueued, ""},
+	"scheduled":            {models.CheckRunQueued, ""},
+	"running":              {models.CheckRunInProgress, ""},
+	"success":              {models.CheckRunCompleted, "success"},
+	"failed":               {models.CheckRunCompleted, "failure"},
+	"canceled":             {models.CheckRunCompleted, "cancelled"},
+	"skipped":              {models.CheckRunCompleted, "skipped"},
+	"manual":               {models.CheckRunCompleted, "skipped"},
+}
+
+// GetCommitChecks reports the jobs that ran on a commit as its check runs, GitLab has no combined status apart
+// from them. Jobs that are allowed to fail don't fail the commit.
+func (s gitlabSource) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, *Response, error) {
+	var jobs []struct {
+		StartedAt    *time.Time `json:"started_at"`
+		FinishedAt   *time.Time `json:"finished_at"`
+		Name         string     `json:"name"`
+		Status       string     `json:"status"`
+		TargetURL    string     `json:"target_url"`
+		AllowFailure bool       `json:"allow_failure"`
+	}
+
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/commits/"+url.PathEscape(sha)+"/statuses", url.Values{
+		"per_page": {"100"},
+	}, &jobs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	checks := &models.CommitChecks{
+		Host:      owner.Host,
+		OwnerName: owner.OwnerName,
+		RepoName:  owner.RepoName,
+		SHA:       sha,
+		Statuses:  make([]*models.CommitStatus, 0),
+		CheckRuns: make([]*models.CheckRun, 0, len(jobs)),
+	}
+
+	for _, job := range jobs {
+		state, known := gitlabCheckStates[job.Status]
+		if !known {
+			state = gitlabCheckState{models.CheckRunCompleted, job.Status}
+		}
+
+		if state.conclusion == "failure" && job.AllowFailure {
+			state.conclusion = "neutral"
+		}
+
+		checks.CheckRuns = append(checks.CheckRuns, &models.CheckRun{
+			StartedAt:   job.StartedAt,
+			CompletedAt: job.FinishedAt,
+			Name:        job.Name,
+			Status:      state.status,
+			Conclusion:  state.conclusion,
+			DetailsURL:  job.TargetURL,
+		})
+	}
+
+	checks.Conclude()
+	return checks, response, nil
+}
+
+// IsAncestor relies on the merge base of two commits being the older one when it is an ancestor of the other.
+func (s gitlabSource) IsAncestor(ctx context.Context, owner models.OwnerAndRepoName, ancestor, descendant string) (bool, *Response, error) {
+	var mergeBase gitlabCommit
+	response, err := s.client.get(ctx, s.project(owner)+"/repository/merge_base", url.Values{
+		"refs[]": {ancestor, descendant},
+	}, &mergeBase)
+	if err != nil {
+		return false, response, err
+	}
+
+	return mergeBase.ID == ancestor, response, nil
+}
+
+func (c gitlabCommit) toCommit(owner models.OwnerAndRepoName) *models.Commit {
+	commit := &models.Commit{
+		SHA:             c.ID,
+		Message:         c.Message,
+		Author:          c.AuthorName,
+		AuthorEmail:     c.AuthorEmail,
+		AuthorDate:      c.AuthoredDate,
+		CommitterName:   c.CommitterName,
+		CommitterEmail:  c.CommitterEmail,
+		Date:            c.CommittedDate,
+		URL:             c.WebURL,
+		Host:            owner.Host,
+		OwnerName:       owner.OwnerName,
+		RepoName:        owner.RepoName,
+		ParentCommitIDs: c.ParentIDs,
+	}
+
+	if commit.ParentCommitIDs == nil {
+		commit.ParentCommitIDs = make([]string, 0)
+	}
+
+	if c.Stats != nil {
+		commit.Additions = c.Stats.Additions
+		commit.Deletions = c.Stats.Deletions
+	}
+
+	return commit
+}
+
+// toCommitFile maps a diff into the GitHub style file changes we store, counting its lines from the patch.
+func (d gitlabDiff) toCommitFile(sha string) *models.CommitFile {
+	file := &models.CommitFile{
+		SHA:      sha,
+		Filename: d.NewPath,
+		Status:   "modified",
+	}
+
+	switch {
+	case d.NewFile:
+		file.Status = "added"
+	case d.DeletedFile:
+		file.Status = "removed"
+	case d.RenamedFile:
+		file.Status = "renamed"
+		file.PreviousFilename = d.OldPath
+	}
+
+	// GitLab diffs start at the first hunk, without the ---/+++ file headers.
+	for _, line := range strings.Split(d.Diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			file.Additions++
+		case strings.HasPrefix(line, "-"):
+			file.Deletions++
+		}
+	}
+
+	file.Changes = file.Additions + file.Deletions
+	return file
+}
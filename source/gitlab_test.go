@@ -0,0 +1,115 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+)
+
+func TestGitlabSourceListCommits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fsub%2Fr/repository/commits" || r.URL.Query().Get("ref_name") != "main" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("RateLimit-Limit", "2000")
+		w.Header().Set("RateLimit-Remaining", "1998")
+		w.Header().Set("RateLimit-Reset", "1700000000")
+		page := r.URL.Query().Get("page")
+		if page == "1" {
+			w.Header().Set("X-Next-Page", "2")
+		} else {
+			w.Header().Set("X-Next-Page", "")
+		}
+		fmt.Fprintf(w, `[{"id":"s%s","parent_ids":["p%s"],"message":"feat: x","author_name":"A","author_email":"a@x",
+			"authored_date":"2024-01-01T00:00:00Z","committed_date":"2024-01-02T00:00:00Z","web_url":"u"}]`, page, page)
+	}))
+	defer server.Close()
+
+	src, err := New(nil, models.SourceHost{Provider: models.ProviderGitlab, BaseURL: server.URL + "/api/v4"}, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := models.OwnerAndRepoName{Host: "gitlab", OwnerName: "group/sub", RepoName: "r"}
+	options := ListCommitsOptions{Branch: "main", Page: 1, PerPage: 100}
+	var shas []string
+	for options.Page != 0 {
+		commits, response, err := src.ListCommits(context.Background(), owner, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Rate.Limit != 2000 || response.Rate.Remaining != 1998 || !response.Rate.Reset.Equal(time.Unix(1700000000, 0)) {
+			t.Errorf("Rate = %+v", response.Rate)
+		}
+		for _, commit := range commits {
+			if commit.Host != "gitlab" || len(commit.ParentCommitIDs) != 1 || commit.Date.Day() != 2 {
+				t.Errorf("commit = %+v", commit)
+			}
+			shas = append(shas, commit.SHA)
+		}
+		options.Page = response.NextPage
+	}
+
+	if fmt.Sprint(shas) != "[s1 s2]" {
+		t.Errorf("ListCommits() listed %v, want [s1 s2]", shas)
+	}
+}
+
+func TestGitlabSourceGetCommit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/o%2Fr/repository/commits/s1":
+			fmt.Fprint(w, `{"id":"s1","stats":{"additions":3,"deletions":1}}`)
+		case "/api/v4/projects/o%2Fr/repository/commits/s1/diff":
+			fmt.Fprint(w, `[{"old_path":"a","new_path":"b","renamed_file":true,"diff":"@@ -1 +1,2 @@\n-x\n+y\n+z"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	src, err := New(nil, models.SourceHost{Provider: models.ProviderGitlab, BaseURL: server.URL + "/api/v4/"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	details, _, err := src.GetCommit(context.Background(), models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}, "s1", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Commit.Additions != 3 || details.Commit.Deletions != 1 || len(details.Files) != 1 {
+		t.Fatalf("GetCommit() = %+v", details)
+	}
+	if file := details.Files[0]; file.Status != "renamed" || file.Additions != 2 || file.Deletions != 1 {
+		t.Errorf("file = %+v, want renamed with 2 additions and 1 deletion", file)
+	}
+}
+
+func TestGitlabSourceErrors(t *testing.T) {
+	owner := models.OwnerAndRepoName{OwnerName: "o", RepoName: "r"}
+	for _, tt := range statusErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.header)
+			src, err := New(nil, models.SourceHost{Provider: models.ProviderGitlab, BaseURL: server.URL + "/api/v4"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = src.GetRepository(context.Background(), owner)
+			checkStatusError(t, err, tt.want)
+			_, _, err = src.ListCommits(context.Background(), owner, ListCommitsOptions{Page: 1, PerPage: 100})
+			checkStatusError(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,450 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// localSource reads bare repositories from disk, laid out as <root>/<owner>/<repo>.git or <root>/<owner>/<repo>,
+// by walking their commit graph. There is no API in between, so there is no rate limit and no linked accounts.
+// Signatures can't be checked without the signers' keys, so signed commits are reported as unverified.
+type localSource struct {
+	root string
+}
+
+func newLocalSource(host models.SourceHost) (*localSource, error) {
+	root := host.BaseURL
+	if strings.HasPrefix(root, "file://") {
+		parsed, err := url.Parse(root)
+		if err != nil {
+			return nil, err
+		}
+		root = parsed.Path
+	}
+
+	if root == "" {
+		return nil, errors.New("local hosts need the directory their repositories are in, e.g. file:///srv/git")
+	}
+
+	return &localSource{root: filepath.Clean(root)}, nil
+}
+
+func (s localSource) open(owner models.OwnerAndRepoName) (*git.Repository, error) {
+	notFound := fmt.Errorf("%w: %s/%s", ErrNotFound, owner.OwnerName, owner.RepoName)
+	for _, name := range []string{owner.OwnerName, owner.RepoName} {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return nil, notFound
+		}
+	}
+
+	// Names are single path elements, so the repository is always two levels below the root.
+	dir := filepath.Join(s.root, owner.OwnerName, owner.RepoName)
+	if rel, err := filepath.Rel(s.root, dir); err != nil || len(strings.Split(filepath.ToSlash(rel), "/")) != 2 {
+		return nil, notFound
+	}
+
+	for _, candidate := range []string{dir + ".git", dir} {
+		repo, err := git.PlainOpen(candidate)
+		if err == nil {
+			return repo, nil
+		}
+
+		if !errors.Is(err, git.ErrRepositoryNotExists) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, dir)
+}
+
+// ListCommits walks the history from the branch head in committer date order, like the hosted APIs list it.
+//
+// The whole history is listed as one page. Resuming the walk at a later page means walking it again from the head,
+// which makes paging through a long history quadratic, and there is no rate limit that paging would spread calls over.
+func (s localSource) ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	head, err := s.resolve(repo, options.Branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logOptions := &git.LogOptions{From: head, Order: git.LogOrderCommitterTime}
+	if !options.Since.IsZero() {
+		logOptions.Since = &options.Since
+	}
+
+	if !options.Until.IsZero() {
+		logOptions.Until = &options.Until
+	}
+
+	iter, err := repo.Log(logOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	commits := make([]*models.Commit, 0)
+	err = iter.ForEach(func(c *object.Commit) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		commits = append(commits, s.toCommit(owner, c))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commits, &Response{}, nil
+}
+
+// GetCommit diffs the commit against its first parent, as the hosted APIs do for merges. Files are never paged.
+func (s localSource) GetCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string, page int) (*CommitDetails, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := s.commit(repo, sha)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parentTree := &object.Tree{}
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	changes, err := object.DiffTreeWithOptions(ctx, parentTree, tree, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	details := &CommitDetails{
+		Commit: s.toCommit(owner, c),
+		Files:  make([]*models.CommitFile, 0, len(changes)),
+	}
+
+	for _, change := range changes {
+		file, err := s.toCommitFile(ctx, sha, change)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		details.Commit.Additions += file.Additions
+		details.Commit.Deletions += file.Deletions
+		details.Files = append(details.Files, file)
+	}
+
+	return details, &Response{}, nil
+}
+
+func (s localSource) ListBranches(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]string, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iter, err := repo.Branches()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0)
+	err = iter.ForEach(func(reference *plumbing.Reference) error {
+		names = append(names, reference.Name().Short())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Strings(names)
+	return names, &Response{}, nil
+}
+
+// GetRepository reads the metadata a bare repository has: the branch HEAD points at, which is the one it was
+// cloned with, and the description git keeps in it. Its last push is when the newest of its branch heads was committed.
+func (s localSource) GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if head.Type() != plumbing.SymbolicReference {
+		return nil, nil, fmt.Errorf("%w: HEAD of %s/%s is detached", ErrNotFound, owner.OwnerName, owner.RepoName)
+	}
+
+	repository := &models.Repository{
+		Host:          owner.Host,
+		OwnerName:     owner.OwnerName,
+		RepoName:      owner.RepoName,
+		DefaultBranch: head.Target().Short(),
+		Description:   s.description(repo),
+		Topics:        []string{},
+	}
+
+	iter, err := repo.Branches()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = iter.ForEach(func(reference *plumbing.Reference) error {
+		commit, err := repo.CommitObject(reference.Hash())
+		if err != nil {
+			return err
+		}
+
+		if pushedAt := commit.Committer.When; repository.PushedAt == nil || pushedAt.After(*repository.PushedAt) {
+			repository.PushedAt = &pushedAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, &Response{}, nil
+}
+
+// description returns what the description file of the repository says, unless it is the placeholder git init writes.
+func (s localSource) description(repo *git.Repository) string {
+	storage, ok := repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return ""
+	}
+
+	file, err := storage.Filesystem().Open("description")
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil || bytes.HasPrefix(content, []byte("Unnamed repository;")) {
+		return ""
+	}
+
+	return strings.TrimSpace(string(content))
+}
+
+// GetRepositoryByID always fails with ErrNotFound, as repositories on disk have no ID besides their path.
+func (s localSource) GetRepositoryByID(ctx context.Context, host string, id int64) (*models.Repository, *Response, error) {
+	return nil, nil, fmt.Errorf("%w: repository %d", ErrNotFound, id)
+}
+
+// ListTags lists every tag at once, peeling annotated tags to the commit they point at.
+func (s localSource) ListTags(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Tag, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags := make([]*models.Tag, 0)
+	err = iter.ForEach(func(reference *plumbing.Reference) error {
+		hash := reference.Hash()
+		if annotated, err := repo.TagObject(hash); err == nil {
+			commit, err := annotated.Commit()
+			if errors.Is(err, object.ErrUnsupportedObject) {
+				return nil // Tags of trees and blobs have no place in the history.
+			}
+			if err != nil {
+				return err
+			}
+			hash = commit.Hash
+		}
+
+		tags = append(tags, &models.Tag{
+			Host:      owner.Host,
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+			Name:      reference.Name().Short(),
+			SHA:       hash.String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, &Response{}, nil
+}
+
+// ListReleases finds none, releases are published on the hosting service, not in the repository.
+func (s localSource) ListReleases(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Release, *Response, error) {
+	return make([]*models.Release, 0), &Response{}, nil
+}
+
+// ListCommitPullRequests finds none, pull requests live on the hosting service, not in the repository.
+func (s localSource) ListCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.PullRequest, *Response, error) {
+	return make([]*models.PullRequest, 0), &Response{}, nil
+}
+
+// GetCommitChecks finds none, CI reports to the hosting service, not to the repository.
+func (s localSource) GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, *Response, error) {
+	checks := &models.CommitChecks{
+		Host:      owner.Host,
+		OwnerName: owner.OwnerName,
+		RepoName:  owner.RepoName,
+		SHA:       sha,
+		Statuses:  make([]*models.CommitStatus, 0),
+		CheckRuns: make([]*models.CheckRun, 0),
+	}
+
+	checks.Conclude()
+	return checks, &Response{}, nil
+}
+
+func (s localSource) IsAncestor(ctx context.Context, owner models.OwnerAndRepoName, ancestor, descendant string) (bool, *Response, error) {
+	repo, err := s.open(owner)
+	if err != nil {
+		return false, nil, err
+	}
+
+	ancestorCommit, err := s.commit(repo, ancestor)
+	if err != nil {
+		return false, nil, err
+	}
+
+	descendantCommit, err := s.commit(repo, descendant)
+	if err != nil {
+		return false, nil, err
+	}
+
+	isAncestor, err := ancestorCommit.IsAncestor(descendantCommit)
+	return isAncestor, &Response{}, err
+}
+
+// resolve returns the head of branch, or of HEAD when branch is empty.
+func (s localSource) resolve(repo *git.Repository, branch string) (plumbing.Hash, error) {
+	name := plumbing.HEAD
+	if branch != "" {
+		name = plumbing.NewBranchReferenceName(branch)
+	}
+
+	reference, err := repo.Reference(name, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return plumbing.ZeroHash, fmt.Errorf("%w: branch %s", ErrNotFound, branch)
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return reference.Hash(), nil
+}
+
+func (s localSource) commit(repo *git.Repository, sha string) (*object.Commit, error) {
+	c, err := repo.CommitObject(plumbing.NewHash(sha))
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return nil, fmt.Errorf("%w: commit %s", ErrNotFound, sha)
+	}
+	return c, err
+}
+
+func (s localSource) toCommit(owner models.OwnerAndRepoName, c *object.Commit) *models.Commit {
+	commit := &models.Commit{
+		SHA:             c.Hash.String(),
+		Message:         c.Message,
+		Author:          c.Author.Name,
+		AuthorEmail:     c.Author.Email,
+		AuthorDate:      c.Author.When,
+		CommitterName:   c.Committer.Name,
+		CommitterEmail:  c.Committer.Email,
+		Date:            c.Committer.When,
+		Host:            owner.Host,
+		OwnerName:       owner.OwnerName,
+		RepoName:        owner.RepoName,
+		ParentCommitIDs: make([]string, 0, len(c.ParentHashes)),
+		Verification: &models.CommitVerification{
+			Reason: models.VerificationUnsigned,
+		},
+	}
+
+	if c.PGPSignature != "" {
+		commit.Verification.Reason = "unknown_key"
+		commit.Verification.Signature = c.PGPSignature
+	}
+
+	for _, parent := range c.ParentHashes {
+		commit.ParentCommitIDs = append(commit.ParentCommitIDs, parent.String())
+	}
+
+	return commit
+}
+
+func (s localSource) toCommitFile(ctx context.Context, sha string, change *object.Change) (*models.CommitFile, error) {
+	action, err := change.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &models.CommitFile{
+		SHA:      sha,
+		Filename: change.To.Name,
+		Status:   "modified",
+	}
+
+	switch {
+	case action == merkletrie.Insert:
+		file.Status = "added"
+	case action == merkletrie.Delete:
+		file.Status = "removed"
+		file.Filename = change.From.Name
+	case change.From.Name != change.To.Name:
+		file.Status = "renamed"
+		file.PreviousFilename = change.From.Name
+	}
+
+	patch, err := change.PatchContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stat := range patch.Stats() {
+		file.Additions += stat.Addition
+		file.Deletions += stat.Deletion
+	}
+
+	file.Changes = file.Additions + file.Deletions
+	return file, nil
+}
@@ -0,0 +1,291 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gitbeam.commit.monitor/models"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// localRepository is a repository on disk laid out for a local host, with the SHAs of the commits made on it.
+type localRepository struct {
+	src     CommitSource
+	owner   models.OwnerAndRepoName
+	commits map[string]string // SHA by commit message.
+}
+
+// newLocalRepository creates <root>/o/r with this history, one hour between commits:
+//
+//	main:    first - second - third
+//	feature: first - side
+//
+// first is tagged v1, second is tagged v2 by an annotated tag.
+func newLocalRepository(t *testing.T) *localRepository {
+	t.Helper()
+	root := t.TempDir()
+	dir := filepath.Join(root, "o", "r")
+
+	repo, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.Main},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	local := &localRepository{
+		owner:   models.OwnerAndRepoName{Host: "disk", OwnerName: "o", RepoName: "r"},
+		commits: make(map[string]string),
+	}
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(message string, files map[string]string) plumbing.Hash {
+		for name, content := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := worktree.Add(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		date = date.Add(time.Hour)
+		signature := &object.Signature{Name: "Jane Doe", Email: "jane@example.com", When: date}
+		hash, err := worktree.Commit(message, &git.CommitOptions{Author: signature, Committer: signature})
+		if err != nil {
+			t.Fatal(err)
+		}
+		local.commits[message] = hash.String()
+		return hash
+	}
+	checkout := func(branch plumbing.ReferenceName, create bool) {
+		if err := worktree.Checkout(&git.CheckoutOptions{Branch: branch, Create: create}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first := commit("first", map[string]string{"a.txt": "one\ntwo\n"})
+	second := commit("second", map[string]string{"a.txt": "one\n2\n", "b.txt": "x\ny\nz\n"})
+	commit("third", map[string]string{"c.txt": "c\n"})
+
+	checkout(plumbing.NewBranchReferenceName("feature"), true)
+	if err := worktree.Reset(&git.ResetOptions{Commit: first, Mode: git.HardReset}); err != nil {
+		t.Fatal(err)
+	}
+	commit("side", map[string]string{"d.txt": "d\n"})
+	checkout(plumbing.Main, false)
+
+	if _, err := repo.CreateTag("v1", first, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTag("v2", second, &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Jane Doe", Email: "jane@example.com", When: date},
+		Message: "Second release",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if local.src, err = New(nil, models.SourceHost{Provider: models.ProviderLocal, BaseURL: "file://" + root}, ""); err != nil {
+		t.Fatal(err)
+	}
+	return local
+}
+
+// messages returns the messages of commits, in order.
+func messages(commits []*models.Commit) string {
+	list := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		list = append(list, commit.Message)
+	}
+	return fmt.Sprint(list)
+}
+
+func TestLocalSourceListCommits(t *testing.T) {
+	local := newLocalRepository(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		options ListCommitsOptions
+		want    string
+	}{
+		{"default branch", ListCommitsOptions{Page: 1, PerPage: 100}, "[third second first]"},
+		{"branch", ListCommitsOptions{Branch: "feature", Page: 1, PerPage: 100}, "[side first]"},
+		{"in one page", ListCommitsOptions{Branch: "main", Page: 1, PerPage: 1}, "[third second first]"},
+		{"since", ListCommitsOptions{Branch: "main", Since: time.Date(2024, 1, 1, 1, 30, 0, 0, time.UTC)}, "[third second]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commits, response, err := local.src.ListCommits(ctx, local.owner, tt.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := messages(commits); got != tt.want {
+				t.Errorf("ListCommits() = %v, want %v", got, tt.want)
+			}
+			if response.NextPage != 0 {
+				t.Errorf("NextPage = %d, want the history in one page", response.NextPage)
+			}
+		})
+	}
+
+	commits, _, err := local.src.ListCommits(ctx, local.owner, ListCommitsOptions{Branch: "main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := commits[1]
+	if second.SHA != local.commits["second"] || second.Host != "disk" || second.AuthorEmail != "jane@example.com" ||
+		fmt.Sprint(second.ParentCommitIDs) != fmt.Sprint([]string{local.commits["first"]}) ||
+		second.Verification.Reason != models.VerificationUnsigned {
+		t.Errorf("commit = %+v", second)
+	}
+}
+
+func TestLocalSourceGetCommit(t *testing.T) {
+	local := newLocalRepository(t)
+
+	details, _, err := local.src.GetCommit(context.Background(), local.owner, local.commits["second"], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Commit.Additions != 4 || details.Commit.Deletions != 1 {
+		t.Errorf("stats = +%d -%d, want +4 -1", details.Commit.Additions, details.Commit.Deletions)
+	}
+
+	files := make([]string, 0, len(details.Files))
+	for _, file := range details.Files {
+		files = append(files, fmt.Sprintf("%s %s +%d -%d", file.Filename, file.Status, file.Additions, file.Deletions))
+	}
+	if want := "[a.txt modified +1 -1 b.txt added +3 -0]"; fmt.Sprint(files) != want {
+		t.Errorf("files = %v, want %v", files, want)
+	}
+
+	// The first commit is diffed against nothing.
+	details, _, err = local.src.GetCommit(context.Background(), local.owner, local.commits["first"], 1)
+	if err != nil || len(details.Files) != 1 || details.Files[0].Status != "added" || details.Commit.Additions != 2 {
+		t.Errorf("GetCommit() = %+v, %v, want a.txt added", details, err)
+	}
+}
+
+func TestLocalSourceListBranches(t *testing.T) {
+	local := newLocalRepository(t)
+
+	branches, _, err := local.src.ListBranches(context.Background(), local.owner, 1)
+	if err != nil || fmt.Sprint(branches) != "[feature main]" {
+		t.Errorf("ListBranches() = %v, %v, want [feature main]", branches, err)
+	}
+}
+
+func TestLocalSourceListTags(t *testing.T) {
+	local := newLocalRepository(t)
+
+	tags, _, err := local.src.ListTags(context.Background(), local.owner, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]string)
+	for _, tag := range tags {
+		got[tag.Name] = tag.SHA
+	}
+	// Annotated tags are peeled to the commit they point at.
+	if want := map[string]string{"v1": local.commits["first"], "v2": local.commits["second"]}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ListTags() = %v, want %v", got, want)
+	}
+}
+
+func TestLocalSourceIsAncestor(t *testing.T) {
+	local := newLocalRepository(t)
+
+	tests := []struct {
+		ancestor, descendant string
+		want                 bool
+	}{
+		{"first", "third", true},
+		{"second", "second", true},
+		{"third", "first", false},
+		{"side", "third", false},
+		{"first", "side", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ancestor+" of "+tt.descendant, func(t *testing.T) {
+			got, _, err := local.src.IsAncestor(context.Background(), local.owner, local.commits[tt.ancestor], local.commits[tt.descendant])
+			if err != nil || got != tt.want {
+				t.Errorf("IsAncestor() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalSourceNotFound(t *testing.T) {
+	local := newLocalRepository(t)
+	ctx := context.Background()
+	missing := "0123456789012345678901234567890123456789"
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"branch", func() error {
+			_, _, err := local.src.ListCommits(ctx, local.owner, ListCommitsOptions{Branch: "gone"})
+			return err
+		}},
+		{"commit", func() error {
+			_, _, err := local.src.GetCommit(ctx, local.owner, missing, 1)
+			return err
+		}},
+		{"ancestor", func() error {
+			_, _, err := local.src.IsAncestor(ctx, local.owner, missing, local.commits["third"])
+			return err
+		}},
+		{"repository", func() error {
+			_, _, err := local.src.ListBranches(ctx, models.OwnerAndRepoName{OwnerName: "o", RepoName: "gone"}, 1)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrNotFound) {
+				t.Errorf("error = %v, want %v", err, ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestLocalSourceStaysInRoot(t *testing.T) {
+	local := newLocalRepository(t)
+
+	// The root itself is a repository here, which no name may lead to.
+	root := local.src.(*localSource).root
+	if _, err := git.PlainInit(root, false); err != nil && !errors.Is(err, git.ErrRepositoryAlreadyExists) {
+		t.Fatal(err)
+	}
+
+	for _, owner := range []models.OwnerAndRepoName{
+		{OwnerName: "o", RepoName: ".."},
+		{OwnerName: "..", RepoName: "o"},
+		{OwnerName: ".", RepoName: "o"},
+		{OwnerName: "o/r", RepoName: ".."},
+		{OwnerName: "o", RepoName: "r/.."},
+		{OwnerName: `o\..`, RepoName: "r"},
+		{OwnerName: "", RepoName: "o"},
+		{OwnerName: "o", RepoName: ""},
+	} {
+		if _, _, err := local.src.ListBranches(context.Background(), owner, 1); !errors.Is(err, ErrNotFound) {
+			t.Errorf("ListBranches(%q, %q) error = %v, want %v", owner.OwnerName, owner.RepoName, err, ErrNotFound)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// restClient makes the JSON API calls of the sources we have no client library for.
+type restClient struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	authHeader string
+	authValue  string
+}
+
+func newRestClient(httpClient *http.Client, baseURL, authHeader, authValue string) (*restClient, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restClient{
+		httpClient: httpClient,
+		baseURL:    parsed,
+		authHeader: authHeader,
+		authValue:  authValue,
+	}, nil
+}
+
+// get decodes the JSON response of the API path, relative to the base URL, into out.
+// Path segments taken from user input must be escaped with url.PathEscape.
+func (c restClient) get(ctx context.Context, path string, query url.Values, out any) (*Response, error) {
+	endpoint, err := c.baseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	if c.authValue != "" {
+		request.Header.Set(c.authHeader, c.authValue)
+	}
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, fromTransportError(err)
+	}
+	defer httpResponse.Body.Close()
+
+	response := parseResponse(httpResponse)
+	switch {
+	case httpResponse.StatusCode == http.StatusNotFound:
+		return response, fmt.Errorf("%w: GET %s", ErrNotFound, endpoint.Path)
+	case httpResponse.StatusCode == http.StatusTooManyRequests,
+		httpResponse.StatusCode == http.StatusForbidden && response.Rate.Limit > 0 && response.Rate.Remaining == 0:
+		return response, &RateLimitError{
+			Reset:      response.Rate.Reset,
+			RetryAfter: response.RetryAfter,
+			Message:    httpResponse.Status,
+		}
+	case httpResponse.StatusCode == http.StatusUnauthorized, httpResponse.StatusCode == http.StatusForbidden:
+		return response, fmt.Errorf("%w: GET %s: %s", ErrAccessDenied, endpoint.Path, httpResponse.Status)
+	case httpResponse.StatusCode >= http.StatusInternalServerError:
+		return response, fmt.Errorf("%w: GET %s: %s", ErrUnavailable, endpoint.Path, httpResponse.Status)
+	case httpResponse.StatusCode < 200 || httpResponse.StatusCode > 299:
+		body, _ := io.ReadAll(io.LimitReader(httpResponse.Body, 512))
+		return response, fmt.Errorf("GET %s: %s: %s", endpoint.Path, httpResponse.Status, strings.TrimSpace(string(body)))
+	}
+
+	return response, json.NewDecoder(httpResponse.Body).Decode(out)
+}
+
+// parseResponse reads paging and rate limits from the headers GitLab and Gitea send, which follow
+// either the IETF RateLimit-* draft or GitHub's X-RateLimit-* ones.
+func parseResponse(httpResponse *http.Response) *Response {
+	response := &Response{}
+	header := httpResponse.Header
+
+	if next, err := strconv.Atoi(header.Get("X-Next-Page")); err == nil {
+		response.NextPage = next
+	} else {
+		response.NextPage = nextPageFromLink(header.Get("Link"))
+	}
+
+	for _, prefix := range []string{"RateLimit-", "X-RateLimit-"} {
+		limit, err := strconv.Atoi(header.Get(prefix + "Limit"))
+		if err != nil {
+			continue
+		}
+
+		response.Rate.Limit = limit
+		response.Rate.Remaining, _ = strconv.Atoi(header.Get(prefix + "Remaining"))
+		if reset, err := strconv.ParseInt(header.Get(prefix+"Reset"), 10, 64); err == nil {
+			response.Rate.Reset = time.Unix(reset, 0)
+		}
+		break
+	}
+
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		response.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return response
+}
+
+// nextPageFromLink returns the page number of the rel="next" URL in a Link header, or 0 when there is none.
+func nextPageFromLink(link string) int {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 || strings.TrimSpace(segments[1]) != `rel="next"` {
+			continue
+		}
+
+		next, err := url.Parse(strings.Trim(strings.TrimSpace(segments[0]), "<>"))
+		if err != nil {
+			return 0
+		}
+
+		page, _ := strconv.Atoi(next.Query().Get("page"))
+		return page
+	}
+
+	return 0
+}
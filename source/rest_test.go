@@ -0,0 +1,204 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type statusErrorTest struct {
+	name   string
+	status int
+	header http.Header
+	want   error
+}
+
+// statusErrorTests are the errors every source reports for the statuses a service fails a call with.
+var statusErrorTests = []statusErrorTest{
+	{"not found", http.StatusNotFound, nil, ErrNotFound},
+	{"unauthorized", http.StatusUnauthorized, nil, ErrAccessDenied},
+	{"forbidden", http.StatusForbidden, nil, ErrAccessDenied},
+	{"internal server error", http.StatusInternalServerError, nil, ErrUnavailable},
+	{"bad gateway", http.StatusBadGateway, nil, ErrUnavailable},
+	{"service unavailable", http.StatusServiceUnavailable, nil, ErrUnavailable},
+	{"out of budget", http.StatusForbidden, http.Header{
+		"X-Ratelimit-Limit":     {"5000"},
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {"1700000000"},
+	}, &RateLimitError{}},
+}
+
+// statusServer fails every call with status, sending header along.
+func statusServer(t *testing.T, status int, header http.Header) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"message":"failed"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// checkStatusError fails the test unless err is want, any RateLimitError matching a RateLimitError.
+func checkStatusError(t *testing.T, err, want error) {
+	t.Helper()
+	var wantRateLimitErr *RateLimitError
+	if errors.As(want, &wantRateLimitErr) {
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			t.Errorf("error = %v, want a RateLimitError", err)
+		}
+		return
+	}
+
+	if !errors.Is(err, want) {
+		t.Errorf("error = %v, want %v", err, want)
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name           string
+		header         http.Header
+		wantNextPage   int
+		wantRate       RateLimit
+		wantRetryAfter time.Duration
+	}{
+		{
+			name: "no headers",
+		},
+		{
+			name:         "next page header",
+			header:       http.Header{"X-Next-Page": {"3"}},
+			wantNextPage: 3,
+		},
+		{
+			name:         "last page header",
+			header:       http.Header{"X-Next-Page": {""}},
+			wantNextPage: 0,
+		},
+		{
+			name: "link header",
+			header: http.Header{"Link": {
+				`<https://x/api/v1/repos/o/r/commits?page=2&limit=50>; rel="next", <https://x/api/v1/repos/o/r/commits?page=9>; rel="last"`,
+			}},
+			wantNextPage: 2,
+		},
+		{
+			name: "link header on the last page",
+			header: http.Header{"Link": {
+				`<https://x/api/v1/repos/o/r/commits?page=1>; rel="first", <https://x/api/v1/repos/o/r/commits?page=8>; rel="prev"`,
+			}},
+			wantNextPage: 0,
+		},
+		{
+			name: "ietf rate limit",
+			header: http.Header{
+				"Ratelimit-Limit":     {"2000"},
+				"Ratelimit-Remaining": {"1999"},
+				"Ratelimit-Reset":     {"1700000000"},
+			},
+			wantRate: RateLimit{Limit: 2000, Remaining: 1999, Reset: time.Unix(1700000000, 0)},
+		},
+		{
+			name: "github style rate limit",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"5000"},
+				"X-Ratelimit-Remaining": {"7"},
+				"X-Ratelimit-Reset":     {"1700000000"},
+			},
+			wantRate: RateLimit{Limit: 5000, Remaining: 7, Reset: time.Unix(1700000000, 0)},
+		},
+		{
+			name:           "retry after",
+			header:         http.Header{"Retry-After": {"30"}},
+			wantRetryAfter: 30 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseResponse(&http.Response{Header: tt.header})
+			if got.NextPage != tt.wantNextPage {
+				t.Errorf("NextPage = %v, want %v", got.NextPage, tt.wantNextPage)
+			}
+			if got.Rate.Limit != tt.wantRate.Limit || got.Rate.Remaining != tt.wantRate.Remaining || !got.Rate.Reset.Equal(tt.wantRate.Reset) {
+				t.Errorf("Rate = %+v, want %+v", got.Rate, tt.wantRate)
+			}
+			if got.RetryAfter != tt.wantRetryAfter {
+				t.Errorf("RetryAfter = %v, want %v", got.RetryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestRestClientGet(t *testing.T) {
+	var gotAuth, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth, gotPath = r.Header.Get("PRIVATE-TOKEN"), r.URL.EscapedPath()
+		fmt.Fprint(w, `{"id":"a1"}`)
+	}))
+	defer server.Close()
+
+	client, err := newRestClient(nil, server.URL+"/api/v4", "PRIVATE-TOKEN", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		ID string `json:"id"`
+	}
+	if _, err := client.get(context.Background(), "projects/o%2Fr", nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID != "a1" || gotAuth != "token" || gotPath != "/api/v4/projects/o%2Fr" {
+		t.Errorf("get() decoded %+v from %v with auth %q", out, gotPath, gotAuth)
+	}
+}
+
+func TestRestClientGetErrors(t *testing.T) {
+	tests := append([]statusErrorTest{
+		{"too many requests", http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}}, &RateLimitError{}},
+	}, statusErrorTests...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := newRestClient(nil, statusServer(t, tt.status, tt.header).URL, "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var out any
+			_, err = client.get(context.Background(), "repos/o/r", nil, &out)
+			checkStatusError(t, err, tt.want)
+		})
+	}
+
+	t.Run("retry after", func(t *testing.T) {
+		client, _ := newRestClient(nil, statusServer(t, http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}}).URL, "", "")
+		var out any
+		_, err := client.get(context.Background(), "repos/o/r", nil, &out)
+
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 3*time.Second {
+			t.Errorf("get() error = %v, want a RateLimitError retrying after 3s", err)
+		}
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		client, _ := newRestClient(nil, statusServer(t, http.StatusBadRequest, nil).URL, "", "")
+		var out any
+		_, err := client.get(context.Background(), "repos/o/r", nil, &out)
+		if err == nil || IsTransient(err) || errors.Is(err, ErrNotFound) || errors.Is(err, ErrAccessDenied) {
+			t.Errorf("get() error = %v, want a permanent error", err)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam.commit.monitor/models"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrNotFound        = errors.New("not found on source")
+	ErrAccessDenied    = errors.New("access denied by source")
+	ErrUnavailable     = errors.New("source is unavailable")
+	ErrUnknownProvider = errors.New("unknown source provider")
+)
+
+// CommitSource is a git hosting service commits are mirrored from.
+//
+// Every call reports the rate limit the service returned along with it, so callers can spread
+// their calls across tokens. Missing repositories, branches and commits are reported as ErrNotFound,
+// credentials the service refused as ErrAccessDenied, and server errors or timeouts as ErrUnavailable.
+type CommitSource interface {
+	// ListCommits lists the commits of a branch from its head, newest first.
+	ListCommits(ctx context.Context, owner models.OwnerAndRepoName, options ListCommitsOptions) ([]*models.Commit, *Response, error)
+	// GetCommit returns a commit with its line stats, and a page of the files it changed.
+	GetCommit(ctx context.Context, owner models.OwnerAndRepoName, sha string, page int) (*CommitDetails, *Response, error)
+	ListBranches(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]string, *Response, error)
+	// GetRepository returns the metadata of a repository, its default branch included. Sources redirecting the old
+	// name of a renamed or transferred repository are followed, the repository then carries its current name.
+	GetRepository(ctx context.Context, owner models.OwnerAndRepoName) (*models.Repository, *Response, error)
+	// GetRepositoryByID returns the metadata of the repository of host with the given Repository.SourceID.
+	GetRepositoryByID(ctx context.Context, host string, id int64) (*models.Repository, *Response, error)
+	// ListTags lists a page of the tags of a repository.
+	ListTags(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Tag, *Response, error)
+	// ListReleases lists a page of the releases of a repository, newest first.
+	ListReleases(ctx context.Context, owner models.OwnerAndRepoName, page int) ([]*models.Release, *Response, error)
+	// ListCommitPullRequests lists the pull requests a commit was pushed to or merged through.
+	ListCommitPullRequests(ctx context.Context, owner models.OwnerAndRepoName, sha string) ([]*models.PullRequest, *Response, error)
+	// GetCommitChecks returns what CI reported on a commit, concluded. It makes up to CommitChecksCalls calls.
+	GetCommitChecks(ctx context.Context, owner models.OwnerAndRepoName, sha string) (*models.CommitChecks, *Response, error)
+	// IsAncestor reports whether descendant is ancestor or reaches it through its parents.
+	IsAncestor(ctx context.Context, owner models.OwnerAndRepoName, ancestor, descendant string) (bool, *Response, error)
+}
+
+// CommitChecksCalls is how many calls GetCommitChecks makes at most, e.g. one for the statuses and one for the
+// check runs of the commit on GitHub.
+const CommitChecksCalls = 2
+
+type ListCommitsOptions struct {
+	Since   time.Time // Zero for no lower bound.
+	Until   time.Time // Zero for no upper bound.
+	Branch  string
+	Cursor  string // Position of the page for sources that page by cursor, from Response.NextCursor.
+	Page    int
+	PerPage int
+}
+
+type CommitDetails struct {
+	Commit *models.Commit
+	Files  []*models.CommitFile
+}
+
+// Response carries what the service told us besides the data of a call.
+type Response struct {
+	Rate       RateLimit
+	NextCursor string        // Set along with NextPage by sources that page by cursor.
+	NextPage   int           // 0 on the last page.
+	RetryAfter time.Duration // Set when the service asked us to slow down.
+	Cost       int           // What the call took off the rate limit, 0 when the service doesn't say.
+}
+
+// RateLimit is the request budget of the token a call was made with. Limit is 0 when the service doesn't report one.
+type RateLimit struct {
+	Reset     time.Time
+	Limit     int
+	Remaining int
+}
+
+// RateLimitError is returned when the service refused a call because the token is out of budget.
+type RateLimitError struct {
+	Reset      time.Time // When the budget resets, zero when unknown.
+	Message    string
+	RetryAfter time.Duration // How long the service asked us to wait, zero when unknown.
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by source: %s", e.Message)
+}
+
+// IsTransient reports whether err may go away by itself, so the call is worth retrying later.
+func IsTransient(err error) bool {
+	var rateLimitErr *RateLimitError
+	return errors.Is(err, ErrUnavailable) || errors.As(err, &rateLimitErr)
+}
+
+// fromTransportError classifies an error that kept a request from getting a response. Cancellations by the
+// caller are returned as they are, anything else is a network problem worth retrying.
+func fromTransportError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+
+	return err
+}
+
+// New returns a CommitSource for host that authenticates with token, or anonymously when token is empty.
+func New(httpClient *http.Client, host models.SourceHost, token string) (CommitSource, error) {
+	switch host.Provider {
+	case models.ProviderGithub, "":
+		return newGithubSource(httpClient, host, token)
+	case models.ProviderGitlab:
+		return newGitlabSource(httpClient, host, token)
+	case models.ProviderGitea:
+		return newGiteaSource(httpClient, host, token)
+	case models.ProviderLocal:
+		return newLocalSource(host)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, host.Provider)
+	}
+}
+
+// NewGraphQL returns a CommitSource for host that lists commits through the GraphQL API of GitHub, which has a rate
+// limit of its own. Every other call goes through the REST API.
+func NewGraphQL(httpClient *http.Client, host models.SourceHost, token string) (CommitSource, error) {
+	switch host.Provider {
+	case models.ProviderGithub, "":
+		return newGithubGraphQLSource(httpClient, host, token)
+	default:
+		return nil, fmt.Errorf("%w: %s has no graphql api", ErrUnknownProvider, host.Provider)
+	}
+}